@@ -5,9 +5,21 @@ import (
 
 	"github.com/bimalpaudels/finks/internal/cli"
 	"github.com/bimalpaudels/finks/internal/installer"
+	buildinfo "github.com/bimalpaudels/finks/internal/version"
+)
+
+// Build information, injected at build time via -ldflags "-X main.version=... -X main.commit=... -X main.date=...".
+var (
+	version = "dev"
+	commit  = "none"
+	date    = "unknown"
 )
 
 func main() {
+	buildinfo.Version = version
+	buildinfo.Commit = commit
+	buildinfo.Date = date
+
 	// When run with no arguments (e.g. from install script), run the installation wizard.
 	if len(os.Args) == 1 {
 		if err := installer.Run(); err != nil {
@@ -2,7 +2,11 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,10 +16,27 @@ import (
 )
 
 var (
-	appPort    string
-	appEnvVars []string
-	appVolumes []string
-	force      bool
+	appPort        string
+	appEnvVars     []string
+	appVolumes     []string
+	appLogDriver   string
+	appLogOpts     []string
+	appLogMaxSize  string
+	appLogMaxFiles string
+	appDomain      string
+	appLocal       bool
+	appSecHeaders  bool
+	force          bool
+	listSort       string
+	listReverse    bool
+	listStatus     string
+	listLabels     []string
+	listImage      string
+	listNoHeader   bool
+	listSeparator  string
+	listIDsOnly    bool
+	listSince      string
+	listOlderThan  string
 )
 
 var appManager *deployment.Manager
@@ -52,7 +73,8 @@ var deployCmd = &cobra.Command{
 Examples:
   finks app deploy nginx --name my-web --port 8080:80
   finks app deploy postgres:13 --name my-db --env POSTGRES_PASSWORD=secret
-  finks app deploy redis --name cache --volume /data:/data`,
+  finks app deploy redis --name cache --volume /data:/data
+  finks app deploy ghost --name blog --port 2368 --domain blog.example.com --security-headers`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		image := args[0]
@@ -61,32 +83,66 @@ Examples:
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 		defer cancel()
 
-		envVars := parseEnvVars(appEnvVars)
+		opts := buildDeployOptions(appName, image)
+		warnSecurityHeadersWithoutTLS(opts)
 
 		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Deploying application '%s' from image '%s'...", appName, image))
 
-		if err := appManager.DeployApp(ctx, appName, image, appPort, envVars, appVolumes); err != nil {
+		if err := appManager.DeployApp(ctx, opts); err != nil {
 			spinner.Fail(fmt.Sprintf("Failed to deploy application: %v", err))
 			return fmt.Errorf("failed to deploy application: %w", err)
 		}
 
 		spinner.Success(fmt.Sprintf("Application '%s' deployed successfully!", appName))
-		if appPort != "" {
+		if appDomain != "" {
+			scheme := "https"
+			if appLocal {
+				scheme = "http"
+			}
+			pterm.Info.Println(fmt.Sprintf("Routed through Traefik at: %s://%s", scheme, appDomain))
+		} else if appPort != "" {
 			pterm.Info.Println(fmt.Sprintf("Available at: http://localhost:%s", strings.Split(appPort, ":")[0]))
 		}
 		return nil
 	},
 }
 
+var createCmd = &cobra.Command{
+	Use:   "create <image> --name <app-name>",
+	Short: "Define an application without deploying it",
+	Long: `Define an application from a Docker image without pulling the image or creating a container.
+The application is deployed the first time it is started with 'finks app start'.
+
+Examples:
+  finks app create postgres:13 --name my-db --env POSTGRES_PASSWORD=secret
+  finks app create nginx --name my-web --port 8080:80
+  finks app start my-db && finks app start my-web`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		image := args[0]
+		appName, _ := cmd.Flags().GetString("name")
+
+		opts := buildDeployOptions(appName, image)
+		warnSecurityHeadersWithoutTLS(opts)
+
+		if err := appManager.CreateApp(opts); err != nil {
+			return fmt.Errorf("failed to create application: %w", err)
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Application '%s' created. Run 'finks app start %s' to deploy it.", appName, appName))
+		return nil
+	},
+}
+
 var startCmd = &cobra.Command{
 	Use:   "start <app-name>",
 	Short: "Start a stopped application",
-	Long:  `Start a previously stopped application.`,
+	Long:  `Start a previously stopped application, or deploy an application defined with 'finks app create'.`,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		appName := args[0]
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 		defer cancel()
 
 		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Starting application '%s'...", appName))
@@ -124,6 +180,52 @@ var stopCmd = &cobra.Command{
 	},
 }
 
+var pauseCmd = &cobra.Command{
+	Use:   "pause <app-name>",
+	Short: "Pause a running application",
+	Long:  `Suspend all processes of a running application without losing its state.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Pausing application '%s'...", appName))
+
+		if err := appManager.PauseApp(ctx, appName); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to pause application: %v", err))
+			return fmt.Errorf("failed to pause application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' paused successfully!", appName))
+		return nil
+	},
+}
+
+var unpauseCmd = &cobra.Command{
+	Use:   "unpause <app-name>",
+	Short: "Unpause a paused application",
+	Long:  `Resume all processes of a previously paused application.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Unpausing application '%s'...", appName))
+
+		if err := appManager.UnpauseApp(ctx, appName); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to unpause application: %v", err))
+			return fmt.Errorf("failed to unpause application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' unpaused successfully!", appName))
+		return nil
+	},
+}
+
 var removeCmd = &cobra.Command{
 	Use:   "remove <app-name>",
 	Short: "Remove an application",
@@ -150,39 +252,243 @@ var removeCmd = &cobra.Command{
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all applications",
-	Long:  `List all deployed applications with their current status.`,
+	Long: `List all deployed applications with their current status.
+
+Use --output table-wide to also show the container ID, health, restart policy and
+volume and environment variable counts. Less important columns are left out when
+the terminal is too narrow.
+
+--filter-image matches images against a glob pattern such as 'postgres*', or
+against a substring when the pattern has no glob characters. It can be combined
+with --label and --filter-status, and an app has to match all of them.
+
+For scripting, --no-header leaves out the header row and --separator changes the
+string between columns. Escapes such as '\t' are understood, and with a custom
+separator the columns are not padded, so fields split cleanly. --ids-only (or
+--names-only) prints just the application names, one per line, like docker ps -q.
+
+--output json lists the names of environment variables but not their values;
+use 'finks app env list' to see those.
+
+--since shows only applications created or updated within the given period, and
+--older-than only those last changed before it. Both take a duration like 12h or
+a number of days like 30d.
+
+Examples:
+  finks app list --sort created --reverse
+  finks app list --output table-wide
+  finks app list --no-header --separator '\t' | awk '{print $1}'
+  finks app list --no-header --separator , | cut -d, -f1,3
+  finks app list --filter-status running --output json | jq '.[].name'
+  finks app list --label env=prod --filter-image 'myapp:*'
+  finks app list --filter-image postgres
+  finks app list --ids-only | xargs -I{} finks app recreate {}
+  finks app list --since 24h
+  finks app list --older-than 30d --filter-status stopped --ids-only | xargs -n1 finks app remove`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		apps, err := appManager.ListApps(ctx)
+		separator, err := parseSeparator(listSeparator)
+		if err != nil {
+			return err
+		}
+
+		labels, err := parseLabelArgs(listLabels)
+		if err != nil {
+			return err
+		}
+
+		filter := deployment.AppFilter{
+			Labels:       labels,
+			ImagePattern: listImage,
+			Status:       listStatus,
+		}
+		if listSince != "" {
+			since, err := parseDayDuration("since", listSince)
+			if err != nil {
+				return err
+			}
+			filter.ChangedSince = time.Now().Add(-since)
+		}
+		if listOlderThan != "" {
+			olderThan, err := parseDayDuration("older-than", listOlderThan)
+			if err != nil {
+				return err
+			}
+			filter.ChangedBefore = time.Now().Add(-olderThan)
+		}
+
+		apps, err := appManager.ListApps(ctx, filter)
 		if err != nil {
 			return fmt.Errorf("failed to list applications: %w", err)
 		}
 
+		if err := deployment.SortApps(apps, listSort, listReverse); err != nil {
+			return err
+		}
+
+		if listIDsOnly {
+			for _, app := range apps {
+				fmt.Println(app.Name)
+			}
+			return nil
+		}
+
+		if strings.EqualFold(outputFormat, "json") {
+			data, err := json.MarshalIndent(appListEntries(apps), "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode applications: %w", err)
+			}
+			fmt.Println(string(data))
+			return nil
+		}
+
 		if len(apps) == 0 {
-			pterm.Info.Println("No applications deployed.")
+			if !listNoHeader {
+				pterm.Info.Println("No applications deployed.")
+			}
 			return nil
 		}
 
-		tableData := pterm.TableData{{"NAME", "IMAGE", "STATUS", "PORT", "CREATED"}}
-		for _, app := range apps {
-			status := getStatusIcon(app.Status) + " " + app.Status
-			port := valueOrDefault(app.Port, "-")
-			tableData = append(tableData, []string{
-				app.Name,
-				app.Image,
-				status,
-				port,
-				app.CreatedAt.Format("2006-01-02 15:04"),
-			})
+		if strings.EqualFold(outputFormat, outputTableWide) {
+			renderListTable(wideAppTableData(ctx, apps), separator)
+			return nil
 		}
 
-		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		renderListTable(appTableData(apps), separator)
 		return nil
 	},
 }
 
+// appListEntry is an application as printed by app list --output json. It leaves
+// out environment values and history, which may hold secrets.
+type appListEntry struct {
+	Name      string    `json:"name"`
+	Image     string    `json:"image"`
+	Status    string    `json:"status"`
+	Port      string    `json:"port,omitempty"`
+	Domain    string    `json:"domain,omitempty"`
+	Networks  []string  `json:"networks,omitempty"`
+	EnvKeys   []string  `json:"env_keys,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func appListEntries(apps []*deployment.App) []appListEntry {
+	entries := make([]appListEntry, 0, len(apps))
+	for _, app := range apps {
+		entries = append(entries, appListEntry{
+			Name:      app.Name,
+			Image:     app.Image,
+			Status:    app.Status,
+			Port:      app.Port,
+			Domain:    app.Domain,
+			Networks:  app.Networks,
+			EnvKeys:   slices.Sorted(maps.Keys(app.EnvVars)),
+			CreatedAt: app.CreatedAt,
+			UpdatedAt: app.UpdatedAt,
+		})
+	}
+	return entries
+}
+
+// renderAppTable prints applications as a table with their status and ports
+func renderAppTable(apps []*deployment.App) {
+	pterm.DefaultTable.WithHasHeader().WithData(appTableData(apps)).Render()
+}
+
+// appTableData lays out applications as table rows with their status and ports
+func appTableData(apps []*deployment.App) pterm.TableData {
+	tableData := pterm.TableData{{"NAME", "IMAGE", "STATUS", "PORT", "NETWORKS", "CREATED"}}
+	for _, app := range apps {
+		status := getStatusIcon(app.Status) + " " + app.Status
+		port := valueOrDefault(app.Port, "-")
+		tableData = append(tableData, []string{
+			app.Name,
+			app.Image,
+			status,
+			port,
+			valueOrDefault(strings.Join(app.Networks, ","), "-"),
+			app.CreatedAt.Format("2006-01-02 15:04"),
+		})
+	}
+	return tableData
+}
+
+// renderListTable prints app list rows, whose first row is the header. A separator
+// other than the pterm default prints the cells unpadded for easy splitting.
+func renderListTable(tableData pterm.TableData, separator string) {
+	if listNoHeader {
+		tableData = tableData[1:]
+	}
+
+	if separator != pterm.DefaultTable.Separator {
+		for _, row := range tableData {
+			fmt.Println(strings.Join(row, separator))
+		}
+		return
+	}
+
+	pterm.DefaultTable.WithHasHeader(!listNoHeader).WithData(tableData).Render()
+}
+
+// parseSeparator interprets Go escape sequences such as \t in a --separator value
+func parseSeparator(value string) (string, error) {
+	if value == "" {
+		return "", fmt.Errorf("--separator must not be empty")
+	}
+	separator, err := strconv.Unquote(`"` + strings.ReplaceAll(value, `"`, `\"`) + `"`)
+	if err != nil {
+		return "", fmt.Errorf("invalid --separator %q: %w", value, err)
+	}
+	return separator, nil
+}
+
+// buildDeployOptions collects the deploy/create flags into deployment options
+func buildDeployOptions(name, image string) deployment.DeployOptions {
+	logOptions := parseEnvVars(appLogOpts)
+	if appLogMaxSize != "" {
+		logOptions["max-size"] = appLogMaxSize
+	}
+	if appLogMaxFiles != "" {
+		logOptions["max-file"] = appLogMaxFiles
+	}
+
+	return deployment.DeployOptions{
+		Name:            name,
+		Image:           image,
+		Port:            appPort,
+		EnvVars:         parseEnvVars(appEnvVars),
+		Volumes:         appVolumes,
+		LogDriver:       appLogDriver,
+		LogOptions:      logOptions,
+		Domain:          appDomain,
+		LocalMode:       appLocal,
+		SecurityHeaders: appSecHeaders,
+	}
+}
+
+// warnSecurityHeadersWithoutTLS warns that HSTS and friends are only honored over HTTPS
+func warnSecurityHeadersWithoutTLS(opts deployment.DeployOptions) {
+	if opts.SecurityHeaders && (opts.Domain == "" || opts.LocalMode) {
+		pterm.Warning.Println("--security-headers has no effect without TLS; browsers ignore HSTS on plain HTTP. Use --domain without --local to serve over HTTPS.")
+	}
+}
+
+// parseLabelArgs parses repeatable KEY=VALUE label flags
+func parseLabelArgs(args []string) (map[string]string, error) {
+	labels := make(map[string]string, len(args))
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --label %q: expected KEY=VALUE", arg)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
 func parseEnvVars(envVars []string) map[string]string {
 	result := make(map[string]string)
 	for _, env := range envVars {
@@ -196,10 +502,14 @@ func parseEnvVars(envVars []string) map[string]string {
 
 func getStatusIcon(status string) string {
 	switch status {
+	case "created":
+		return "🆕"
 	case "running":
 		return "🟢"
 	case "stopped":
 		return "🔴"
+	case "paused":
+		return "⏸️"
 	case "failed":
 		return "❌"
 	default:
@@ -208,13 +518,46 @@ func getStatusIcon(status string) string {
 }
 
 func init() {
-	appCmd.AddCommand(deployCmd, startCmd, stopCmd, removeCmd, listCmd)
+	appCmd.AddCommand(deployCmd, createCmd, startCmd, stopCmd, pauseCmd, unpauseCmd, removeCmd, listCmd, topCmd, logsCmd, diffCmd,
+		inspectCmd, connectAppCmd, disconnectAppCmd, pruneCmd, renameCmd, resourcesCmd, eventsCmd, backupCmd, migrateCmd, scheduleCmd, generateComposeCmd, importComposeCmd, watchCmd, networkPolicyCmd, healthcheckStatusCmd, updateAppCmd, lintCmd, domainCmd, recreateCmd, envCmd, copyVolumeCmd, autorestartCmd, rollbackEnvCmd)
 
 	deployCmd.Flags().String("name", "", "Name of the application (required)")
 	deployCmd.Flags().StringVarP(&appPort, "port", "p", "", "Port mapping (e.g., 8080:80)")
 	deployCmd.Flags().StringSliceVarP(&appEnvVars, "env", "e", []string{}, "Environment variables (e.g., KEY=VALUE)")
 	deployCmd.Flags().StringSliceVarP(&appVolumes, "volume", "v", []string{}, "Volume mounts (e.g., /host:/container)")
+	deployCmd.Flags().StringVar(&appLogDriver, "log-driver", "json-file", "Logging driver for the container")
+	deployCmd.Flags().StringArrayVar(&appLogOpts, "log-opt", []string{}, "Logging driver option (e.g., max-size=10m), repeatable")
+	deployCmd.Flags().StringVar(&appLogMaxSize, "log-max-size", "", "Maximum size of a log file before rotation (e.g., 10m)")
+	deployCmd.Flags().StringVar(&appLogMaxFiles, "log-max-files", "", "Maximum number of rotated log files to keep")
+	deployCmd.Flags().StringVar(&appDomain, "domain", "", "Route this domain to the application through Traefik")
+	deployCmd.Flags().BoolVar(&appLocal, "local", false, "Serve --domain over plain HTTP instead of HTTPS with Let's Encrypt")
+	deployCmd.Flags().BoolVar(&appSecHeaders, "security-headers", false, "Add HSTS and other security headers to the application's router")
 	deployCmd.MarkFlagRequired("name")
 
+	createCmd.Flags().String("name", "", "Name of the application (required)")
+	createCmd.Flags().StringVarP(&appPort, "port", "p", "", "Port mapping (e.g., 8080:80)")
+	createCmd.Flags().StringSliceVarP(&appEnvVars, "env", "e", []string{}, "Environment variables (e.g., KEY=VALUE)")
+	createCmd.Flags().StringSliceVarP(&appVolumes, "volume", "v", []string{}, "Volume mounts (e.g., /host:/container)")
+	createCmd.Flags().StringVar(&appLogDriver, "log-driver", "json-file", "Logging driver for the container")
+	createCmd.Flags().StringArrayVar(&appLogOpts, "log-opt", []string{}, "Logging driver option (e.g., max-size=10m), repeatable")
+	createCmd.Flags().StringVar(&appLogMaxSize, "log-max-size", "", "Maximum size of a log file before rotation (e.g., 10m)")
+	createCmd.Flags().StringVar(&appLogMaxFiles, "log-max-files", "", "Maximum number of rotated log files to keep")
+	createCmd.Flags().StringVar(&appDomain, "domain", "", "Route this domain to the application through Traefik")
+	createCmd.Flags().BoolVar(&appLocal, "local", false, "Serve --domain over plain HTTP instead of HTTPS with Let's Encrypt")
+	createCmd.Flags().BoolVar(&appSecHeaders, "security-headers", false, "Add HSTS and other security headers to the application's router")
+	createCmd.MarkFlagRequired("name")
+
 	removeCmd.Flags().BoolVarP(&force, "force", "f", false, "Force remove running application")
+
+	listCmd.Flags().StringVar(&listSort, "sort", deployment.SortByName, "Sort by field (name, status, created, updated)")
+	listCmd.Flags().BoolVar(&listReverse, "reverse", false, "Reverse the sort order")
+	listCmd.Flags().StringVar(&listStatus, "filter-status", "", "Only show applications with this status (e.g., running, stopped, failed)")
+	listCmd.Flags().StringArrayVar(&listLabels, "label", []string{}, "Only show applications with this container label, as KEY=VALUE (repeatable)")
+	listCmd.Flags().StringVar(&listImage, "filter-image", "", "Only show applications whose image matches this glob pattern or substring")
+	listCmd.Flags().BoolVar(&listNoHeader, "no-header", false, "Do not print the header row")
+	listCmd.Flags().StringVar(&listSeparator, "separator", pterm.DefaultTable.Separator, "String printed between columns (e.g. '\\t' or ,)")
+	listCmd.Flags().StringVar(&listSince, "since", "", "Only show applications created or updated within this period (e.g. 24h, 7d)")
+	listCmd.Flags().StringVar(&listOlderThan, "older-than", "", "Only show applications last created or updated longer ago than this (e.g. 30d)")
+	listCmd.Flags().BoolVar(&listIDsOnly, "ids-only", false, "Only print application names, one per line")
+	listCmd.Flags().BoolVar(&listIDsOnly, "names-only", false, "Alias for --ids-only")
 }
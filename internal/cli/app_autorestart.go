@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	autorestartOnOOM   bool
+	autorestartDisable bool
+)
+
+var autorestartCmd = &cobra.Command{
+	Use:   "autorestart <app-name>",
+	Short: "Restart an application when it is killed for running out of memory",
+	Long: `Restart an application's container after the kernel kills it for running out
+of memory, for apps without a Docker restart policy that covers it.
+
+The setting is saved in apps.json. The restarts are done by 'finks scheduler start',
+which watches the Docker events of every app with the setting and picks up changes
+within a minute. Run it under a service manager such as systemd.
+
+Examples:
+  finks app autorestart my-web --on-oom
+  finks app autorestart my-web --disable`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		if autorestartOnOOM == autorestartDisable {
+			return fmt.Errorf("specify either --on-oom or --disable")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := appManager.SetAutoRestartOnOOM(ctx, appName, autorestartOnOOM); err != nil {
+			return fmt.Errorf("failed to update auto-restart: %w", err)
+		}
+
+		if autorestartDisable {
+			pterm.Success.Println(fmt.Sprintf("Application '%s' will no longer be restarted after OOM kills", appName))
+			return nil
+		}
+		pterm.Success.Println(fmt.Sprintf("Application '%s' will be restarted after OOM kills", appName))
+		pterm.Info.Println("Restarts happen while 'finks scheduler start' is running")
+		return nil
+	},
+}
+
+func init() {
+	autorestartCmd.Flags().BoolVar(&autorestartOnOOM, "on-oom", false, "Restart the application after an out-of-memory kill")
+	autorestartCmd.Flags().BoolVar(&autorestartDisable, "disable", false, "Stop restarting the application after out-of-memory kills")
+}
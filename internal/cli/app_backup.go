@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/docker/go-units"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backupOutput     string
+	backupStopBefore bool
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup <app-name>",
+	Short: "Back up an application's volume data",
+	Long: `Archive all bind mounts and named volumes of an application into
+<output>/<app-name>_<timestamp>.tar.gz.
+
+A running application is paused while the archive is taken. Use
+--stop-before-backup to stop it instead, which is safer for databases.
+
+Examples:
+  finks app backup my-db --output /backups
+  finks app backup my-db --output /backups --stop-before-backup`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Backing up application '%s'...", appName))
+
+		result, err := appManager.BackupApp(ctx, appName, deployment.BackupOptions{
+			OutputDir:        backupOutput,
+			StopBeforeBackup: backupStopBefore,
+		})
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to back up application: %v", err))
+			return fmt.Errorf("failed to back up application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' backed up to %s", appName, result.Path))
+		pterm.Info.Println(fmt.Sprintf("Size:   %s", units.BytesSize(float64(result.Size))))
+		pterm.Info.Println(fmt.Sprintf("SHA256: %s", result.SHA256))
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.Flags().StringVarP(&backupOutput, "output", "o", ".", "Directory to write the backup archive to")
+	backupCmd.Flags().BoolVar(&backupStopBefore, "stop-before-backup", false, "Stop the container instead of pausing it during the backup")
+}
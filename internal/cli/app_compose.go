@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var composeOutput string
+
+var generateComposeCmd = &cobra.Command{
+	Use:   "generate-compose <app-name>",
+	Short: "Export an application as a Docker Compose file",
+	Long: `Export the stored configuration of an application as a docker-compose.yml
+that can be started with 'docker compose up'. Traefik labels, networks and
+volumes are included.
+
+Examples:
+  finks app generate-compose my-web
+  finks app generate-compose my-web -o docker-compose.yml`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		data, err := appManager.GenerateCompose(appName)
+		if err != nil {
+			return fmt.Errorf("failed to generate compose file: %w", err)
+		}
+
+		if composeOutput == "" {
+			fmt.Print(string(data))
+			return nil
+		}
+
+		if err := os.WriteFile(composeOutput, data, 0644); err != nil {
+			return fmt.Errorf("failed to write compose file: %w", err)
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Compose file written to %s", composeOutput))
+		return nil
+	},
+}
+
+var importComposeService string
+
+var importComposeCmd = &cobra.Command{
+	Use:   "import-compose <compose-file>",
+	Short: "Deploy applications from a Docker Compose file",
+	Long: `Deploy each service of a docker-compose.yml as a finks application, in
+depends_on order. Services that build their own image are skipped, since finks
+only deploys existing images.
+
+Examples:
+  finks app import-compose docker-compose.yml
+  finks app import-compose docker-compose.yml --service web`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		services, err := deployment.ParseComposeFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to import compose file: %w", err)
+		}
+
+		if importComposeService != "" {
+			services = slices.DeleteFunc(services, func(s deployment.ComposeService) bool {
+				return s.Name != importComposeService
+			})
+			if len(services) == 0 {
+				return fmt.Errorf("service %s not found in %s", importComposeService, args[0])
+			}
+		}
+
+		var deployed int
+		for _, service := range services {
+			if service.Build || service.Options.Image == "" {
+				pterm.Warning.Println(fmt.Sprintf("Skipping '%s': finks does not build images", service.Name))
+				continue
+			}
+			if _, err := appManager.GetApp(service.Name); err == nil {
+				pterm.Warning.Println(fmt.Sprintf("Skipping '%s': application already exists", service.Name))
+				continue
+			}
+			for _, warning := range service.Warnings {
+				pterm.Warning.Println(fmt.Sprintf("%s: %s", service.Name, warning))
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+			spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Deploying '%s' (%s)...", service.Name, service.Options.Image))
+			err := appManager.DeployApp(ctx, service.Options)
+			cancel()
+			if err != nil {
+				spinner.Fail(fmt.Sprintf("Failed to deploy '%s': %v", service.Name, err))
+				return fmt.Errorf("failed to deploy service %s: %w", service.Name, err)
+			}
+
+			spinner.Success(fmt.Sprintf("Application '%s' deployed", service.Name))
+			deployed++
+		}
+
+		pterm.Info.Println(fmt.Sprintf("Deployed %d of %d service(s)", deployed, len(services)))
+		return nil
+	},
+}
+
+func init() {
+	generateComposeCmd.Flags().StringVarP(&composeOutput, "output", "o", "", "Write the compose file to this path instead of stdout")
+	importComposeCmd.Flags().StringVar(&importComposeService, "service", "", "Deploy only this service")
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var copyVolumeCmd = &cobra.Command{
+	Use:   "copy-volume <src-app> <src-path> <dst-app> <dst-path>",
+	Short: "Copy data from one application's container to another",
+	Long: `Copy a directory or file from one application's container to another, for
+example when moving data to a new service. The data is streamed as a tar archive
+without being stored on the host. The contents of a directory are copied into
+dst-path, which is created if needed. The destination application must be running.
+
+Copying from a running application, such as a database, may give an inconsistent
+copy; stop it first if its data changes while the copy runs.
+
+Examples:
+  finks app copy-volume monolith /data/uploads uploads /data
+  finks app copy-volume old-db /var/lib/postgresql/data new-db /var/lib/postgresql/data`,
+	Args: cobra.ExactArgs(4),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		srcApp, srcPath, dstApp, dstPath := args[0], args[1], args[2], args[3]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Hour)
+		defer cancel()
+
+		if _, detail, err := appManager.InspectApp(ctx, srcApp); err == nil && detail != nil && detail.Status == "running" {
+			pterm.Warning.Println(fmt.Sprintf("Application '%s' is running; the copy may be inconsistent if it is writing to %s (e.g. a database)", srcApp, srcPath))
+		}
+
+		message := fmt.Sprintf("Copying %s:%s to %s:%s...", srcApp, srcPath, dstApp, dstPath)
+		spinner, _ := pterm.DefaultSpinner.Start(message)
+
+		progress := func(copied int64) {
+			spinner.UpdateText(fmt.Sprintf("%s %s", message, units.HumanSize(float64(copied))))
+		}
+
+		copied, err := appManager.CopyVolume(ctx, srcApp, srcPath, dstApp, dstPath, progress)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to copy data: %v", err))
+			return fmt.Errorf("failed to copy data: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Copied %s from '%s' to '%s'", units.HumanSize(float64(copied)), srcApp, dstApp))
+		return nil
+	},
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <app-name>",
+	Short: "Show drift between stored config and the live container",
+	Long: `Compare the running container against the configuration stored by finks.
+Lines prefixed with '-' show the current container value, '+' the desired value.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		diffs, err := appManager.DiffApp(ctx, appName)
+		if err != nil {
+			return fmt.Errorf("failed to diff application: %w", err)
+		}
+
+		if len(diffs) == 0 {
+			pterm.Success.Println("No drift detected.")
+			return nil
+		}
+
+		printFieldDiffs(diffs)
+		return nil
+	},
+}
+
+// printFieldDiffs prints each difference with the current value in red and the desired value in green
+func printFieldDiffs(diffs []deployment.FieldDiff) {
+	for _, d := range diffs {
+		fmt.Println(pterm.Bold.Sprint(d.Field))
+		fmt.Println(pterm.FgRed.Sprintf("  - %s", valueOrDefault(d.Current, "(none)")))
+		fmt.Println(pterm.FgGreen.Sprintf("  + %s", valueOrDefault(d.Desired, "(none)")))
+	}
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var domainCheckRouting bool
+
+var domainCmd = &cobra.Command{
+	Use:   "domain",
+	Short: "Manage application domains",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var verifyDomainCmd = &cobra.Command{
+	Use:   "verify <app-name> <domain>",
+	Short: "Check that a domain points at this server",
+	Long: `Check that a domain is ready for an application before enabling HTTPS, so
+Let's Encrypt does not fail on a domain that points elsewhere:
+
+  1. DNS: the domain resolves to A or AAAA records
+  2. IP: one of those records is this server's public IP
+  3. Routing (with --check-routing): a request for the domain reaches this
+     server's Traefik. A temporary container serves a random token under
+     /.well-known/finks-verify/ and the token is fetched through the domain.
+
+Examples:
+  finks app domain verify my-web example.com
+  finks app domain verify my-web example.com --check-routing`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName, domain := args[0], strings.ToLower(args[1])
+
+		app, err := appManager.GetApp(appName)
+		if err != nil {
+			return err
+		}
+		if app.Domain != "" && app.Domain != domain {
+			pterm.Warning.Println(fmt.Sprintf("%s is routed for %s, not %s", appName, app.Domain, domain))
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		failed := 0
+		report := func(check string, err error, detail string) {
+			if err != nil {
+				failed++
+				fmt.Printf("%s  %-8s %v\n", pterm.FgRed.Sprint("FAIL"), check, err)
+				return
+			}
+			fmt.Printf("%s  %-8s %s\n", pterm.FgGreen.Sprint("PASS"), check, detail)
+		}
+
+		addrs, err := proxy.LookupDomain(ctx, domain)
+		report("DNS", err, fmt.Sprintf("%s resolves to %s", domain, strings.Join(addrs, ", ")))
+
+		if err == nil {
+			publicIPs, err := proxy.PublicIPs(ctx)
+			if err == nil && !slices.ContainsFunc(addrs, func(addr string) bool { return slices.Contains(publicIPs, addr) }) {
+				err = fmt.Errorf("%s does not resolve to this server (%s)", domain, strings.Join(publicIPs, ", "))
+			}
+			report("IP", err, fmt.Sprintf("%s points at this server (%s)", domain, strings.Join(publicIPs, ", ")))
+		}
+
+		if domainCheckRouting {
+			client, err := docker.NewClient()
+			if err != nil {
+				return fmt.Errorf("failed to initialize Docker client: %w", err)
+			}
+			defer client.Close()
+
+			err = proxy.VerifyRouting(ctx, client, domain)
+			report("Routing", err, fmt.Sprintf("http://%s reaches Traefik on this server", domain))
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("%d check(s) failed for %s", failed, domain)
+		}
+		return nil
+	},
+}
+
+func init() {
+	domainCmd.AddCommand(verifyDomainCmd)
+
+	verifyDomainCmd.Flags().BoolVar(&domainCheckRouting, "check-routing", false, "Also check that HTTP requests for the domain reach Traefik on this server")
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// maskedValue replaces the values of environment variables that may hold secrets
+const maskedValue = "****"
+
+var (
+	envShowValues  bool
+	envYes         bool
+	envMaskPattern string
+)
+
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Short: "Manage application environment variables",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var listEnvCmd = &cobra.Command{
+	Use:   "list <app-name>",
+	Short: "List the environment variables of an application",
+	Long: `List the environment variables of an application.
+
+Values of variables whose key matches --mask-pattern (matched case-insensitively)
+are shown as ****, as they may hold credentials; other values are shown as is.
+--show-values reveals every value after asking for confirmation, which --yes skips.
+Masking applies to --output json too.
+
+Examples:
+  finks app env list my-web
+  finks app env list my-web --mask-pattern 'PASSWORD|DSN'
+  finks app env list my-web --show-values --yes
+  finks app env list my-web --output json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		mask, err := regexp.Compile("(?i)" + envMaskPattern)
+		if err != nil {
+			return fmt.Errorf("invalid --mask-pattern: %w", err)
+		}
+
+		app, err := appManager.GetApp(appName)
+		if err != nil {
+			return err
+		}
+
+		if envShowValues && !envYes {
+			confirmed, _ := pterm.DefaultInteractiveConfirm.Show("Values may contain credentials. Show them?")
+			if !confirmed {
+				pterm.Info.Println("Cancelled.")
+				return nil
+			}
+		}
+
+		env := make(map[string]string, len(app.EnvVars))
+		for key, value := range app.EnvVars {
+			if !envShowValues && envMaskPattern != "" && mask.MatchString(key) {
+				value = maskedValue
+			}
+			env[key] = value
+		}
+
+		if strings.EqualFold(outputFormat, "json") {
+			data, err := json.MarshalIndent(env, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode environment variables: %w", err)
+			}
+			fmt.Println(string(data))
+			return nil
+		}
+
+		if len(env) == 0 {
+			pterm.Info.Println(fmt.Sprintf("Application '%s' has no environment variables.", appName))
+			return nil
+		}
+
+		tableData := pterm.TableData{{"KEY", "VALUE"}}
+		for _, key := range slices.Sorted(maps.Keys(env)) {
+			tableData = append(tableData, []string{key, env[key]})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+func init() {
+	envCmd.AddCommand(listEnvCmd, importEnvCmd, historyEnvCmd)
+
+	listEnvCmd.Flags().BoolVar(&envShowValues, "show-values", false, "Show the values of variables that would be masked")
+	listEnvCmd.Flags().BoolVarP(&envYes, "yes", "y", false, "Do not ask for confirmation before showing values")
+	listEnvCmd.Flags().StringVar(&envMaskPattern, "mask-pattern", "PASSWORD|SECRET|TOKEN|KEY|CREDENTIAL", "Mask the values of variables whose key matches this regular expression (empty masks nothing)")
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var rollbackEnvTo int
+
+var historyEnvCmd = &cobra.Command{
+	Use:   "history <app-name>",
+	Short: "Show the previous environments of an application",
+	Long: `Show the environments an application had before its last environment updates,
+most recent first. Up to 10 are kept. The CHANGES column lists the keys the update
+that replaced each environment added (+), changed (~) or removed (-). Values are
+not shown, as they may hold credentials.
+
+Restore one with 'finks app rollback-env <app-name> --to <#>'.
+
+Examples:
+  finks app env history my-web`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		app, err := appManager.GetApp(appName)
+		if err != nil {
+			return err
+		}
+
+		if len(app.EnvHistory) == 0 {
+			pterm.Info.Println(fmt.Sprintf("Application '%s' has no environment history.", appName))
+			return nil
+		}
+
+		tableData := pterm.TableData{{"#", "REPLACED AT", "VARIABLES", "CHANGES"}}
+		for i := len(app.EnvHistory) - 1; i >= 0; i-- {
+			snapshot := app.EnvHistory[i]
+			next := app.EnvVars
+			if i+1 < len(app.EnvHistory) {
+				next = app.EnvHistory[i+1].Env
+			}
+			tableData = append(tableData, []string{
+				strconv.Itoa(len(app.EnvHistory) - i),
+				snapshot.ReplacedAt.Local().Format("2006-01-02 15:04:05"),
+				strconv.Itoa(len(snapshot.Env)),
+				valueOrDefault(envChanges(snapshot.Env, next), "-"),
+			})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+var rollbackEnvCmd = &cobra.Command{
+	Use:   "rollback-env <app-name>",
+	Short: "Restore a previous environment of an application",
+	Long: `Restore the environment an application had before its last environment update,
+or before an earlier one with --to, and recreate its container. The numbers are
+those shown by 'finks app env history'. The restored snapshot and the more recent
+ones are removed from the history.
+
+Examples:
+  finks app rollback-env my-web
+  finks app rollback-env my-web --to 3`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Rolling back environment of '%s'...", appName))
+
+		diff, err := appManager.RollbackAppEnv(ctx, appName, rollbackEnvTo)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to roll back environment: %v", err))
+			return fmt.Errorf("failed to roll back environment: %w", err)
+		}
+
+		if diff.Empty() {
+			spinner.Info("Environment unchanged; the container was not recreated")
+			return nil
+		}
+
+		spinner.Success(fmt.Sprintf("Environment of '%s' rolled back", appName))
+		printEnvDiff(diff)
+		return nil
+	},
+}
+
+func init() {
+	rollbackEnvCmd.Flags().IntVar(&rollbackEnvTo, "to", 1, "Snapshot to restore, as numbered by 'app env history'")
+}
+
+// envChanges summarizes the keys that differ between two environments, like +NEW ~CHANGED -REMOVED
+func envChanges(before, after map[string]string) string {
+	var changes []string
+	for _, key := range slices.Sorted(maps.Keys(after)) {
+		old, ok := before[key]
+		switch {
+		case !ok:
+			changes = append(changes, "+"+key)
+		case old != after[key]:
+			changes = append(changes, "~"+key)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(before)) {
+		if _, ok := after[key]; !ok {
+			changes = append(changes, "-"+key)
+		}
+	}
+	return strings.Join(changes, " ")
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	envImportSecret string
+	envImportPrefix string
+	envImportDryRun bool
+)
+
+var importEnvCmd = &cobra.Command{
+	Use:   "import <app-name>",
+	Short: "Import environment variables from a Kubernetes Secret",
+	Long: `Set environment variables of an application from a Kubernetes Secret manifest
+and recreate its container with them, like 'finks app update --env-add'.
+
+Values under data are base64-decoded and values under stringData are used as is.
+A file without a kind is read as plain KEY: base64value pairs. --prefix is added to
+every imported key. With --dry-run, the keys that would be added or changed are
+listed and nothing is applied.
+
+Examples:
+  finks app env import my-web --from-k8s-secret secret.yaml
+  finks app env import my-web --from-k8s-secret secret.yaml --prefix DB_ --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		secret, err := deployment.ParseK8sSecretFile(envImportSecret)
+		if err != nil {
+			return err
+		}
+		set := make(map[string]string, len(secret))
+		for key, value := range secret {
+			set[envImportPrefix+key] = value
+		}
+
+		if envImportDryRun {
+			diff, err := appManager.PreviewAppEnv(appName, set, nil)
+			if err != nil {
+				return err
+			}
+			if diff.Empty() {
+				pterm.Info.Println(fmt.Sprintf("Environment of '%s' already has these values", appName))
+				return nil
+			}
+			pterm.Info.Println(fmt.Sprintf("Would import %d variable(s) into '%s'", len(diff.Added)+len(diff.Changed), appName))
+			printEnvDiff(diff)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Importing environment into '%s'...", appName))
+
+		diff, err := appManager.UpdateAppEnv(ctx, appName, set, nil)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to import environment: %v", err))
+			return fmt.Errorf("failed to import environment: %w", err)
+		}
+
+		if diff.Empty() {
+			spinner.Info("Environment unchanged; the container was not recreated")
+			return nil
+		}
+
+		spinner.Success(fmt.Sprintf("Imported %d variable(s) into '%s'", len(diff.Added)+len(diff.Changed), appName))
+		printEnvDiff(diff)
+		return nil
+	},
+}
+
+func init() {
+	importEnvCmd.Flags().StringVar(&envImportSecret, "from-k8s-secret", "", "Kubernetes Secret manifest to import (YAML)")
+	importEnvCmd.Flags().StringVar(&envImportPrefix, "prefix", "", "Prefix added to every imported key")
+	importEnvCmd.Flags().BoolVar(&envImportDryRun, "dry-run", false, "Show the variables that would change without applying them")
+	importEnvCmd.MarkFlagRequired("from-k8s-secret")
+}
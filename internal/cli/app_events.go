@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/spf13/cobra"
+)
+
+var eventsSince string
+
+var eventsCmd = &cobra.Command{
+	Use:   "events <app-name>",
+	Short: "Stream Docker events for an application",
+	Long: `Stream container events such as start, stop, die, oom and health_status.
+Useful for diagnosing OOM kills, crash loops and failing health checks.
+
+Examples:
+  finks app events my-web
+  finks app events my-web --since 1h`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		events, err := appManager.WatchAppEvents(ctx, appName, eventsSince)
+		if err != nil {
+			return fmt.Errorf("failed to watch application events: %w", err)
+		}
+
+		for event := range events {
+			fmt.Println(formatContainerEvent(event))
+		}
+
+		if ctx.Err() == nil {
+			return fmt.Errorf("event stream for %s closed unexpectedly", appName)
+		}
+		return nil
+	},
+}
+
+// formatContainerEvent renders an event as "2024-01-15 10:23:01  container:die  exitCode=1".
+// Container labels are also reported as attributes; they are dotted keys and are left out.
+func formatContainerEvent(event docker.ContainerEvent) string {
+	var attrs []string
+	for key, value := range event.Attributes {
+		if key == "name" || key == "image" || strings.Contains(key, ".") {
+			continue
+		}
+		attrs = append(attrs, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(attrs)
+
+	line := fmt.Sprintf("%s  %s:%s", event.Time.Format("2006-01-02 15:04:05"), event.Type, event.Action)
+	if len(attrs) > 0 {
+		line += "  " + strings.Join(attrs, " ")
+	}
+	return line
+}
+
+func init() {
+	eventsCmd.Flags().StringVar(&eventsSince, "since", "", "Show events since a timestamp or relative time (e.g. 1h)")
+}
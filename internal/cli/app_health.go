@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+const (
+	healthWatchInterval = 10 * time.Second
+	healthOutputWidth   = 80
+)
+
+var healthWatch bool
+
+var healthcheckStatusCmd = &cobra.Command{
+	Use:   "healthcheck-status <app-name>",
+	Short: "Show recent health check results of an application",
+	Long: `Show the last health check attempts Docker recorded for an application's
+container, newest first. The image or deployment must define a health check.
+
+Examples:
+  finks app healthcheck-status my-web
+  finks app healthcheck-status my-web --watch`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		if !healthWatch {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			table, err := renderHealthHistory(ctx, appName)
+			if err != nil {
+				return err
+			}
+			fmt.Print(table)
+			return nil
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		area, err := pterm.DefaultArea.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start display: %w", err)
+		}
+		defer area.Stop()
+
+		ticker := time.NewTicker(healthWatchInterval)
+		defer ticker.Stop()
+
+		for {
+			table, err := renderHealthHistory(ctx, appName)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			area.Update(table)
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+func renderHealthHistory(ctx context.Context, appName string) (string, error) {
+	history, err := appManager.GetAppHealthHistory(ctx, appName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get health check status: %w", err)
+	}
+
+	if len(history) == 0 {
+		return pterm.Warning.Sprintln(fmt.Sprintf("No health check results for '%s'; the container has no health check", appName)), nil
+	}
+
+	tableData := pterm.TableData{{"STARTED", "DURATION", "EXIT CODE", "OUTPUT"}}
+	for i := len(history) - 1; i >= 0; i-- {
+		result := history[i]
+		tableData = append(tableData, []string{
+			result.Start.Local().Format("2006-01-02 15:04:05"),
+			result.End.Sub(result.Start).Round(time.Millisecond).String(),
+			healthExitCode(result),
+			truncateOutput(result.Output, healthOutputWidth),
+		})
+	}
+
+	table, err := pterm.DefaultTable.WithHasHeader().WithData(tableData).Srender()
+	if err != nil {
+		return "", fmt.Errorf("failed to render table: %w", err)
+	}
+	return table + "\n", nil
+}
+
+func healthExitCode(result docker.HealthCheckResult) string {
+	if result.ExitCode == 0 {
+		return pterm.FgGreen.Sprint(strconv.Itoa(result.ExitCode))
+	}
+	return pterm.FgRed.Sprint(strconv.Itoa(result.ExitCode))
+}
+
+// truncateOutput flattens health check output to one line of at most width characters
+func truncateOutput(output string, width int) string {
+	output = strings.Join(strings.Fields(output), " ")
+	if runes := []rune(output); len(runes) > width {
+		return string(runes[:width-1]) + "…"
+	}
+	return output
+}
+
+func init() {
+	healthcheckStatusCmd.Flags().BoolVarP(&healthWatch, "watch", "w", false, "Refresh every 10 seconds")
+}
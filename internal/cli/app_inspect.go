@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var inspectFormat string
+
+// inspectTemplateFuncs are the helper functions available to inspect --format templates
+var inspectTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+}
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect <app-name>",
+	Short: "Show detailed information about an application",
+	Long: `Show the stored configuration of an application along with details of its live container.
+
+With --format, the stored configuration is rendered with a Go template instead of
+the table. Templates can use any field of the application (Name, Image, Port,
+EnvVars, Volumes, Networks, Domain, Status, CreatedAt, ...) and the functions
+json, upper, lower and trimPrefix.
+
+Examples:
+  finks app inspect my-web
+  finks app inspect my-web --format '{{.Image}}:{{.Port}}'
+  finks app inspect my-web --format '{{.Image | trimPrefix "docker.io/"}}'
+  finks app inspect my-web --format '{{json .}}'`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		var tmpl *template.Template
+		if inspectFormat != "" {
+			var err error
+			tmpl, err = template.New("format").Funcs(inspectTemplateFuncs).Parse(inspectFormat)
+			if err != nil {
+				return fmt.Errorf("invalid --format template: %w", err)
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		app, detail, err := appManager.InspectApp(ctx, appName)
+		if err != nil {
+			return fmt.Errorf("failed to inspect application: %w", err)
+		}
+
+		if tmpl != nil {
+			if err := tmpl.Execute(os.Stdout, app); err != nil {
+				return fmt.Errorf("failed to render --format template: %w", err)
+			}
+			fmt.Println()
+			return nil
+		}
+
+		envKeys := make([]string, 0, len(app.EnvVars))
+		for key := range app.EnvVars {
+			envKeys = append(envKeys, key)
+		}
+		sort.Strings(envKeys)
+
+		networks := app.Networks
+		containerID := "-"
+		started := "-"
+		var addresses []string
+		if detail != nil {
+			networks = detail.NetworkNames()
+			containerID = detail.ID
+			if len(containerID) > 12 {
+				containerID = containerID[:12]
+			}
+			if !detail.StartedAt.IsZero() {
+				started = detail.StartedAt.Local().Format("2006-01-02 15:04")
+			}
+			for _, networkName := range networks {
+				ip, err := appManager.GetAppIP(ctx, appName, networkName)
+				if err != nil {
+					return fmt.Errorf("failed to inspect application: %w", err)
+				}
+				addresses = append(addresses, fmt.Sprintf("%s=%s", networkName, valueOrDefault(ip, "-")))
+			}
+		}
+
+		tableData := pterm.TableData{
+			{"Name", app.Name},
+			{"Image", app.Image},
+			{"Status", getStatusIcon(app.Status) + " " + app.Status},
+			{"Container", containerID},
+			{"Port", valueOrDefault(app.Port, "-")},
+			{"Env", valueOrDefault(strings.Join(envKeys, ", "), "-")},
+			{"Volumes", valueOrDefault(strings.Join(app.Volumes, ", "), "-")},
+			{"Networks", valueOrDefault(strings.Join(networks, ", "), "-")},
+			{"IP Addresses", valueOrDefault(strings.Join(addresses, ", "), "-")},
+			{"Created", app.CreatedAt.Format("2006-01-02 15:04")},
+			{"Updated", app.UpdatedAt.Format("2006-01-02 15:04")},
+			{"Started", started},
+		}
+
+		pterm.DefaultTable.WithData(tableData).Render()
+		return nil
+	},
+}
+
+var connectAppCmd = &cobra.Command{
+	Use:     "connect <app-name> <network-name>",
+	Aliases: []string{"network-add"},
+	Short:   "Connect an application to an additional network",
+	Long: `Connect a deployed application's container to an additional Docker network.
+'finks network connect-app <network-name> <app-name>' does the same.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName, networkName := args[0], args[1]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Connecting application '%s' to network '%s'...", appName, networkName))
+
+		if err := appManager.AddNetwork(ctx, appName, networkName); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to connect application: %v", err))
+			return fmt.Errorf("failed to connect application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' connected to network '%s'!", appName, networkName))
+		return nil
+	},
+}
+
+var disconnectAppCmd = &cobra.Command{
+	Use:   "disconnect <app-name> <network-name>",
+	Short: "Disconnect an application from a network",
+	Long:  `Disconnect a deployed application's container from a Docker network.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName, networkName := args[0], args[1]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Disconnecting application '%s' from network '%s'...", appName, networkName))
+
+		if err := appManager.RemoveNetwork(ctx, appName, networkName); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to disconnect application: %v", err))
+			return fmt.Errorf("failed to disconnect application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' disconnected from network '%s'!", appName, networkName))
+		return nil
+	},
+}
+
+func init() {
+	inspectCmd.Flags().StringVarP(&inspectFormat, "format", "f", "", "Render the application with a Go template instead of a table")
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var lintStrict bool
+
+var lintCmd = &cobra.Command{
+	Use:   "lint <app-name>",
+	Short: "Check an application's configuration for best practices",
+	Long: `Check a deployed application's container for common configuration mistakes,
+such as running privileged, missing resource limits or secrets in plain
+environment variables. Each finding shows its severity and a suggested fix.
+
+The command fails when an error is found. With --strict, warnings fail it too.
+
+Examples:
+  finks app lint my-web
+  finks app lint my-web --strict`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		findings, err := appManager.LintApp(ctx, appName)
+		if err != nil {
+			return fmt.Errorf("failed to lint application: %w", err)
+		}
+
+		if len(findings) == 0 {
+			pterm.Success.Printf("No issues found in %s\n", appName)
+			return nil
+		}
+
+		counts := make(map[string]int)
+		for _, finding := range findings {
+			counts[finding.Severity]++
+			fmt.Printf("%s %s: %s\n", lintSeverityLabel(finding.Severity), finding.Check, finding.Message)
+			fmt.Printf("        fix: %s\n", finding.Fix)
+		}
+		fmt.Printf("\n%d error(s), %d warning(s), %d info\n",
+			counts[deployment.LintError], counts[deployment.LintWarning], counts[deployment.LintInfo])
+
+		if counts[deployment.LintError] > 0 {
+			return fmt.Errorf("%s has %d configuration error(s)", appName, counts[deployment.LintError])
+		}
+		if lintStrict && counts[deployment.LintWarning] > 0 {
+			return fmt.Errorf("%s has %d configuration warning(s)", appName, counts[deployment.LintWarning])
+		}
+		return nil
+	},
+}
+
+// lintSeverityLabel returns a fixed-width, colored label for a finding severity
+func lintSeverityLabel(severity string) string {
+	label := fmt.Sprintf("%-7s", severity)
+	switch severity {
+	case deployment.LintError:
+		return pterm.FgRed.Sprint(label)
+	case deployment.LintWarning:
+		return pterm.FgYellow.Sprint(label)
+	default:
+		return pterm.FgCyan.Sprint(label)
+	}
+}
+
+func init() {
+	lintCmd.Flags().BoolVar(&lintStrict, "strict", false, "Exit with an error when any warning is found")
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/mattn/go-runewidth"
+	"github.com/pterm/pterm"
+)
+
+// outputTableWide is the --output value that makes app list show container details
+const outputTableWide = "table-wide"
+
+// wideColumn is one column of the wide app table. Columns with a lower priority
+// are dropped first when the table does not fit the terminal; NAME and STATUS
+// have no priority and are always shown.
+type wideColumn struct {
+	header   string
+	priority int
+	value    func(app *deployment.App, detail *docker.ContainerDetail) string
+}
+
+var wideColumns = []wideColumn{
+	{"NAME", 0, func(app *deployment.App, _ *docker.ContainerDetail) string { return app.Name }},
+	{"CONTAINER ID", 3, func(_ *deployment.App, detail *docker.ContainerDetail) string {
+		if detail == nil {
+			return "-"
+		}
+		return detail.ID[:min(len(detail.ID), 12)]
+	}},
+	{"IMAGE", 7, func(app *deployment.App, _ *docker.ContainerDetail) string { return app.Image }},
+	{"STATUS", 0, func(app *deployment.App, _ *docker.ContainerDetail) string {
+		return getStatusIcon(app.Status) + " " + app.Status
+	}},
+	{"HEALTH", 8, func(_ *deployment.App, detail *docker.ContainerDetail) string {
+		if detail == nil {
+			return "-"
+		}
+		if detail.Health == nil {
+			return "none"
+		}
+		return valueOrDefault(detail.Health.Status, "none")
+	}},
+	{"PORT", 6, func(app *deployment.App, _ *docker.ContainerDetail) string { return valueOrDefault(app.Port, "-") }},
+	{"NETWORKS", 5, func(app *deployment.App, detail *docker.ContainerDetail) string {
+		networks := app.Networks
+		if detail != nil {
+			networks = detail.NetworkNames()
+		}
+		return valueOrDefault(strings.Join(networks, ","), "-")
+	}},
+	{"VOLUMES", 2, func(app *deployment.App, _ *docker.ContainerDetail) string { return strconv.Itoa(len(app.Volumes)) }},
+	{"ENV VARS", 1, func(app *deployment.App, _ *docker.ContainerDetail) string { return strconv.Itoa(len(app.EnvVars)) }},
+	{"RESTART POLICY", 4, func(_ *deployment.App, detail *docker.ContainerDetail) string {
+		if detail == nil {
+			return "-"
+		}
+		return valueOrDefault(detail.RestartPolicy, "no")
+	}},
+}
+
+// wideAppTableData lays out applications with details of their containers, dropping
+// the least important columns until the table fits the terminal
+func wideAppTableData(ctx context.Context, apps []*deployment.App) pterm.TableData {
+	rows := make([][]string, 0, len(apps)+1)
+	header := make([]string, len(wideColumns))
+	for i, column := range wideColumns {
+		header[i] = column.header
+	}
+	rows = append(rows, header)
+
+	for _, app := range apps {
+		// Apps whose container is missing are still listed, with container columns empty
+		_, detail, _ := appManager.InspectApp(ctx, app.Name)
+		row := make([]string, len(wideColumns))
+		for i, column := range wideColumns {
+			row[i] = column.value(app, detail)
+		}
+		rows = append(rows, row)
+	}
+
+	shown := make([]bool, len(wideColumns))
+	for i := range shown {
+		shown[i] = true
+	}
+	termWidth := pterm.GetTerminalWidth()
+	for tableWidth(rows, shown) > termWidth {
+		drop := -1
+		for i, column := range wideColumns {
+			if shown[i] && column.priority > 0 && (drop < 0 || column.priority < wideColumns[drop].priority) {
+				drop = i
+			}
+		}
+		if drop < 0 {
+			break
+		}
+		shown[drop] = false
+	}
+
+	tableData := make(pterm.TableData, len(rows))
+	for r, row := range rows {
+		for i, cell := range row {
+			if shown[i] {
+				tableData[r] = append(tableData[r], cell)
+			}
+		}
+	}
+	return tableData
+}
+
+// tableWidth is the rendered width of the shown columns, including the " | " separators
+func tableWidth(rows [][]string, shown []bool) int {
+	width := -3
+	for i := range shown {
+		if !shown[i] {
+			continue
+		}
+		widths := make([]int, len(rows))
+		for r, row := range rows {
+			widths[r] = runewidth.StringWidth(row[i])
+		}
+		width += slices.Max(widths) + 3
+	}
+	return width
+}
@@ -0,0 +1,322 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/docker/go-units"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+const (
+	logStreamStdout = "stdout"
+	logStreamStderr = "stderr"
+)
+
+var (
+	logsFollow  bool
+	logsTail    string
+	logsSince   string
+	logsFilter  string
+	logsInvert  bool
+	logsContext int
+	logsOutput  string
+	logsRotate  string
+	logsTimes   bool
+	logsJSON    bool
+	logsJQ      string
+	logsMux     bool
+	logsNoPref  bool
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs <app-name> [app-name...]",
+	Short: "Show application logs",
+	Long: `Show the logs of an application. Lines written to stderr are highlighted.
+
+With --multiplex, the logs of several applications are interleaved in timestamp
+order, each line prefixed with its application's name in a distinct color.
+
+Examples:
+  finks app logs my-web --follow
+  finks app logs my-web --tail 100 --filter "ERROR|WARN"
+  finks app logs my-web --filter healthcheck --invert
+  finks app logs my-web --filter panic --context 5
+  finks app logs my-web --follow --output-file /var/log/my-web.log --rotate-size 100MB
+  finks app logs my-web --since 10m --timestamps
+  finks app logs my-web --json
+  finks app logs my-web --jq 'select(.level == "error") | .msg'
+  finks app logs --multiplex my-web my-worker --follow`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !logsMux && len(args) > 1 {
+			return fmt.Errorf("accepts 1 app name, received %d; use --multiplex to show the logs of several apps", len(args))
+		}
+		if logsNoPref && !logsMux {
+			return fmt.Errorf("--no-prefix requires --multiplex")
+		}
+
+		if logsContext < 0 {
+			return fmt.Errorf("--context must not be negative")
+		}
+
+		filter := &logFilter{context: logsContext, invert: logsInvert}
+		if logsFilter != "" {
+			re, err := regexp.Compile(logsFilter)
+			if err != nil {
+				return fmt.Errorf("invalid --filter pattern: %w", err)
+			}
+			filter.re = re
+		} else if logsInvert || logsContext > 0 {
+			return fmt.Errorf("--invert and --context require --filter")
+		}
+
+		if logsRotate != "" && logsOutput == "" {
+			return fmt.Errorf("--rotate-size requires --output-file")
+		}
+
+		var formatter *jsonLogFormatter
+		if logsJSON || logsJQ != "" {
+			var err error
+			formatter, err = newJSONLogFormatter(logsJSON, logsJQ)
+			if err != nil {
+				return err
+			}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		var printer func(logLine)
+		var writeErr error
+		if logsOutput != "" {
+			var maxSize int64
+			if logsRotate != "" {
+				size, err := units.FromHumanSize(logsRotate)
+				if err != nil {
+					return fmt.Errorf("invalid --rotate-size: %w", err)
+				}
+				maxSize = size
+			}
+
+			file, err := openRotatingLogFile(logsOutput, maxSize)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			printer = func(line logLine) {
+				if writeErr != nil {
+					return
+				}
+				// Stop streaming on the first write failure
+				if writeErr = file.WriteLine(line); writeErr != nil {
+					stop()
+				}
+			}
+		} else {
+			printer = printLogLine
+		}
+
+		opts := docker.LogOptions{
+			Follow:     logsFollow,
+			Tail:       logsTail,
+			Since:      logsSince,
+			Timestamps: logsTimes || logsMux,
+		}
+
+		var err error
+		if logsMux {
+			err = streamMultiplexedLogs(ctx, args, opts, filter, formatter, printer)
+		} else {
+			err = streamLogs(ctx, args[0], opts, filter, formatter, printer)
+		}
+		if writeErr != nil {
+			return writeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get application logs: %w", err)
+		}
+		return nil
+	},
+}
+
+// streamLogs prints the logs of one application through filter and formatter
+func streamLogs(ctx context.Context, appName string, opts docker.LogOptions, filter *logFilter, formatter *jsonLogFormatter, printer func(logLine)) error {
+	handle := func(line logLine) {
+		var stamp string
+		if logsTimes {
+			stamp, line.text = splitLogTimestamp(line.text)
+		}
+		texts := []string{line.text}
+		if formatter != nil {
+			texts = formatter.format(line.text)
+		}
+		for _, text := range texts {
+			if stamp != "" {
+				text = stamp + " " + text
+			}
+			filter.process(logLine{stream: line.stream, text: text}, printer)
+		}
+	}
+
+	stdout := newLogLineWriter(logStreamStdout, handle)
+	stderr := newLogLineWriter(logStreamStderr, handle)
+
+	err := appManager.StreamAppLogs(ctx, appName, opts, stdout, stderr)
+	stdout.Flush()
+	stderr.Flush()
+	return err
+}
+
+// printLogLine writes a log line to the terminal, highlighting stderr
+func printLogLine(line logLine) {
+	if line.separator {
+		fmt.Println(pterm.FgGray.Sprint("--"))
+		return
+	}
+	if line.stream == logStreamStderr {
+		fmt.Fprintln(os.Stderr, pterm.FgRed.Sprint(line.text))
+		return
+	}
+	fmt.Println(line.text)
+}
+
+// splitLogTimestamp separates the RFC3339Nano timestamp Docker prepends to each
+// line from the rest of the line and reformats it as a shorter local HH:MM:SS.mmm
+func splitLogTimestamp(text string) (string, string) {
+	t, rest, ok := parseLogTimestamp(text)
+	if !ok {
+		return "", text
+	}
+	return t.Local().Format("15:04:05.000"), rest
+}
+
+// parseLogTimestamp splits the RFC3339Nano timestamp Docker prepends to each line
+// from the rest of the line
+func parseLogTimestamp(text string) (time.Time, string, bool) {
+	stamp, rest, ok := strings.Cut(text, " ")
+	if !ok {
+		stamp, rest = text, ""
+	}
+	t, err := time.Parse(time.RFC3339Nano, stamp)
+	if err != nil {
+		return time.Time{}, text, false
+	}
+	return t, rest, true
+}
+
+// logLine is a single demultiplexed log line
+type logLine struct {
+	stream    string
+	text      string
+	separator bool // marks a gap between non-contiguous context groups
+}
+
+// logLineWriter splits a log stream into lines and hands each one to fn
+type logLineWriter struct {
+	stream string
+	fn     func(logLine)
+	buf    bytes.Buffer
+}
+
+func newLogLineWriter(stream string, fn func(logLine)) *logLineWriter {
+	return &logLineWriter{stream: stream, fn: fn}
+}
+
+func (w *logLineWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		idx := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if idx < 0 {
+			break
+		}
+		line := string(bytes.TrimRight(w.buf.Next(idx+1), "\r\n"))
+		w.fn(logLine{stream: w.stream, text: line})
+	}
+	return len(p), nil
+}
+
+// Flush emits any trailing data that was not terminated by a newline
+func (w *logLineWriter) Flush() {
+	if w.buf.Len() > 0 {
+		w.fn(logLine{stream: w.stream, text: w.buf.String()})
+		w.buf.Reset()
+	}
+}
+
+// logFilter applies a grep-like filter with optional context lines
+type logFilter struct {
+	re      *regexp.Regexp
+	invert  bool
+	context int
+
+	before    []logLine
+	afterLeft int
+	printed   bool
+	skipped   bool
+}
+
+func (f *logFilter) process(line logLine, emit func(logLine)) {
+	if f.re == nil {
+		emit(line)
+		return
+	}
+
+	if f.re.MatchString(line.text) != f.invert {
+		if f.context > 0 && f.printed && f.skipped {
+			emit(logLine{separator: true})
+		}
+		for _, l := range f.before {
+			emit(l)
+		}
+		f.before = f.before[:0]
+		emit(line)
+		f.printed = true
+		f.skipped = false
+		f.afterLeft = f.context
+		return
+	}
+
+	if f.afterLeft > 0 {
+		emit(line)
+		f.afterLeft--
+		return
+	}
+
+	if f.context == 0 {
+		f.skipped = true
+		return
+	}
+
+	f.before = append(f.before, line)
+	if len(f.before) > f.context {
+		f.before = f.before[1:]
+		f.skipped = true
+	}
+}
+
+func init() {
+	logsCmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "Follow log output")
+	logsCmd.Flags().StringVarP(&logsTail, "tail", "n", "all", "Number of lines to show from the end of the logs")
+	logsCmd.Flags().StringVar(&logsSince, "since", "", "Show logs since timestamp or relative duration (e.g. 10m)")
+	logsCmd.Flags().StringVar(&logsFilter, "filter", "", "Only show lines matching this regular expression")
+	logsCmd.Flags().BoolVar(&logsInvert, "invert", false, "Show lines that do not match --filter")
+	logsCmd.Flags().IntVarP(&logsContext, "context", "C", 0, "Lines of context to show around each match")
+	logsCmd.Flags().StringVar(&logsOutput, "output-file", "", "Append logs to this file instead of printing them")
+	logsCmd.Flags().StringVar(&logsRotate, "rotate-size", "", "Rotate the output file to <path>.1 at this size (e.g. 100MB)")
+	logsCmd.Flags().BoolVarP(&logsTimes, "timestamps", "t", false, "Prefix each line with the time Docker recorded it")
+	logsCmd.Flags().BoolVar(&logsJSON, "json", false, "Pretty-print lines that are JSON and mark the rest as unstructured")
+	logsCmd.Flags().StringVar(&logsJQ, "jq", "", "Apply a jq filter to lines that are JSON (e.g. .msg)")
+	logsCmd.Flags().BoolVar(&logsMux, "multiplex", false, "Interleave the logs of all given applications")
+	logsCmd.Flags().BoolVar(&logsNoPref, "no-prefix", false, "Do not prefix multiplexed lines with the application name")
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/itchyny/gojq"
+)
+
+// unstructuredPrefix marks log lines that are not JSON when structured output is requested
+const unstructuredPrefix = "[unstructured] "
+
+// jsonLogFormatter pretty-prints JSON log lines and optionally runs a jq filter on them
+type jsonLogFormatter struct {
+	indent bool
+	query  *gojq.Code
+}
+
+func newJSONLogFormatter(indent bool, expr string) (*jsonLogFormatter, error) {
+	f := &jsonLogFormatter{indent: indent}
+	if expr == "" {
+		return f, nil
+	}
+
+	parsed, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	f.query = code
+	return f, nil
+}
+
+// format turns one log line into the lines to display. A jq filter can produce
+// any number of results, including none.
+func (f *jsonLogFormatter) format(text string) []string {
+	trimmed := bytes.TrimSpace([]byte(text))
+	if !json.Valid(trimmed) {
+		return []string{unstructuredPrefix + text}
+	}
+
+	if f.query == nil {
+		return []string{f.encode(trimmed)}
+	}
+
+	var input any
+	if err := json.Unmarshal(trimmed, &input); err != nil {
+		return []string{unstructuredPrefix + text}
+	}
+
+	var lines []string
+	iter := f.query.Run(input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, isErr := v.(error); isErr {
+			lines = append(lines, fmt.Sprintf("[jq error] %v", err))
+			break
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			lines = append(lines, fmt.Sprintf("[jq error] %v", err))
+			continue
+		}
+		lines = append(lines, f.encode(data))
+	}
+	return lines
+}
+
+func (f *jsonLogFormatter) encode(data []byte) string {
+	if !f.indent {
+		return string(data)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/pterm/pterm"
+)
+
+// multiplexDelay is how long lines are held back so that lines from all applications
+// can be put in timestamp order
+const multiplexDelay = 100 * time.Millisecond
+
+// multiplexColors are cycled through for the application name prefixes
+var multiplexColors = []pterm.Color{pterm.FgCyan, pterm.FgGreen, pterm.FgYellow, pterm.FgMagenta}
+
+// multiplexedLine is a log line waiting to be printed in timestamp order
+type multiplexedLine struct {
+	at       time.Time
+	received time.Time
+	seq      int
+	line     logLine
+}
+
+// logMultiplexer merges lines from several log streams in timestamp order
+type logMultiplexer struct {
+	mu      sync.Mutex
+	pending []multiplexedLine
+	seq     int
+	emit    func(logLine)
+}
+
+func (m *logMultiplexer) add(at time.Time, line logLine) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seq++
+	m.pending = append(m.pending, multiplexedLine{at: at, received: time.Now(), seq: m.seq, line: line})
+}
+
+// flush emits buffered lines in timestamp order. Unless all is set, it stops at the
+// first line received within multiplexDelay, as an earlier line may still arrive.
+func (m *logMultiplexer) flush(all bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	slices.SortStableFunc(m.pending, func(a, b multiplexedLine) int {
+		if c := a.at.Compare(b.at); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	cutoff := time.Now().Add(-multiplexDelay)
+	n := 0
+	for n < len(m.pending) && (all || !m.pending[n].received.After(cutoff)) {
+		m.emit(m.pending[n].line)
+		n++
+	}
+	m.pending = slices.Delete(m.pending, 0, n)
+}
+
+// streamMultiplexedLogs streams the logs of several applications at once and prints
+// them interleaved in timestamp order, each line prefixed with its application's name
+func streamMultiplexedLogs(ctx context.Context, appNames []string, opts docker.LogOptions, filter *logFilter, formatter *jsonLogFormatter, printer func(logLine)) error {
+	width := 0
+	for _, name := range appNames {
+		if _, err := appManager.GetApp(name); err != nil {
+			return err
+		}
+		width = max(width, len(name))
+	}
+
+	mux := &logMultiplexer{emit: printer}
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(multiplexDelay / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				mux.flush(false)
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(appNames))
+	for i, name := range appNames {
+		var prefix string
+		if !logsNoPref {
+			prefix = fmt.Sprintf("%-*s | ", width, name)
+			// Colors would end up as escape codes in --output-file
+			if logsOutput == "" {
+				prefix = multiplexColors[i%len(multiplexColors)].Sprint(prefix)
+			}
+		}
+		appFilter := &logFilter{re: filter.re, invert: filter.invert, context: filter.context}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var at time.Time
+			handle := func(line logLine) {
+				t, text, ok := parseLogTimestamp(line.text)
+				switch {
+				case ok:
+					at = t
+				case at.IsZero():
+					at = time.Now()
+				}
+
+				texts := []string{text}
+				if formatter != nil {
+					texts = formatter.format(text)
+				}
+				for _, text := range texts {
+					if logsTimes && ok {
+						text = t.Local().Format("15:04:05.000") + " " + text
+					}
+					appFilter.process(logLine{stream: line.stream, text: text}, func(l logLine) {
+						if !l.separator {
+							l.text = prefix + l.text
+						}
+						mux.add(at, l)
+					})
+				}
+			}
+
+			stdout := newLogLineWriter(logStreamStdout, handle)
+			stderr := newLogLineWriter(logStreamStderr, handle)
+			if err := appManager.StreamAppLogs(ctx, name, opts, stdout, stderr); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", name, err)
+			}
+			stdout.Flush()
+			stderr.Flush()
+		}()
+	}
+
+	wg.Wait()
+	close(done)
+	mux.flush(true)
+	return errors.Join(errs...)
+}
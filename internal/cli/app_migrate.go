@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateCmdLine string
+	migrateImage   string
+	migrateTimeout time.Duration
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate <app-name> --cmd <migration-command>",
+	Short: "Run a migration before updating an application",
+	Long: `Run a one-off migration command in a temporary container with the application's
+environment, volumes and networks. The command runs through /bin/sh -c.
+
+If --image is given, the migration runs with the new image and the application is
+updated to it once the migration succeeds. A failed migration leaves the
+application untouched.
+
+Examples:
+  finks app migrate my-web --cmd "python manage.py migrate" --image my-web:v2
+  finks app migrate my-web --cmd "rails db:migrate" --timeout 10m`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		// The migration timeout is applied separately; leave room for pulling and updating
+		ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout+10*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Running migration for '%s'...", appName))
+
+		var output bytes.Buffer
+		err := appManager.RunMigration(ctx, appName, deployment.MigrateOptions{
+			Image:   migrateImage,
+			Command: migrateCmdLine,
+			Timeout: migrateTimeout,
+		}, &output)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Migration failed: %v", err))
+			if output.Len() > 0 {
+				fmt.Print(output.String())
+			}
+			return fmt.Errorf("failed to run migration: %w", err)
+		}
+
+		spinner.Success("Migration completed successfully!")
+		if output.Len() > 0 {
+			fmt.Print(output.String())
+		}
+
+		if migrateImage == "" {
+			return nil
+		}
+
+		spinner, _ = pterm.DefaultSpinner.Start(fmt.Sprintf("Updating application '%s' to '%s'...", appName, migrateImage))
+		if err := appManager.UpdateApp(ctx, appName, migrateImage); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to update application: %v", err))
+			return fmt.Errorf("failed to update application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' updated to '%s'", appName, migrateImage))
+		return nil
+	},
+}
+
+func init() {
+	migrateCmd.Flags().StringVar(&migrateCmdLine, "cmd", "", "Migration command to run (required)")
+	migrateCmd.Flags().StringVar(&migrateImage, "image", "", "Image to migrate with and update the application to")
+	migrateCmd.Flags().DurationVar(&migrateTimeout, "timeout", 300*time.Second, "Kill the migration if it runs longer than this")
+	migrateCmd.MarkFlagRequired("cmd")
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	policyDenyEgress  bool
+	policyAllowEgress bool
+	policyAllowTo     []string
+)
+
+var networkPolicyCmd = &cobra.Command{
+	Use:   "network-policy <app-name>",
+	Short: "Restrict an application's outbound traffic",
+	Long: `Move an application onto its own isolated network with no route outside
+the host, so it cannot open outbound connections.
+
+--allow-egress-to accepts container or application names, which are joined to
+the isolated network, and CIDRs. Docker cannot filter traffic by destination,
+so with CIDRs the network disables masquerading instead: only networks that
+route the container subnet back to this host can answer.
+
+The application leaves all its other networks, so ports are no longer
+published and Traefik stops routing to it. --allow-egress removes the policy:
+the application rejoins the networks it was on and the isolated network is removed.
+The isolated network follows the application through 'finks app rename' and is
+removed with it by 'finks app remove'.
+
+Examples:
+  finks app network-policy payments --deny-egress
+  finks app network-policy payments --deny-egress --allow-egress-to postgres
+  finks app network-policy payments --deny-egress --allow-egress-to 10.20.0.0/16
+  finks app network-policy payments --allow-egress`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		if policyDenyEgress == policyAllowEgress {
+			return fmt.Errorf("exactly one of --deny-egress or --allow-egress is required")
+		}
+		if policyAllowEgress && len(policyAllowTo) > 0 {
+			return fmt.Errorf("--allow-egress-to requires --deny-egress")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if policyAllowEgress {
+			spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Removing network policy of '%s'...", appName))
+			if err := appManager.AllowEgress(ctx, appName); err != nil {
+				spinner.Fail(fmt.Sprintf("Failed to remove network policy: %v", err))
+				return fmt.Errorf("failed to remove network policy: %w", err)
+			}
+			spinner.Success(fmt.Sprintf("Outbound traffic allowed for '%s'", appName))
+			return nil
+		}
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Isolating application '%s'...", appName))
+
+		if err := appManager.DenyEgress(ctx, appName, policyAllowTo); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to apply network policy: %v", err))
+			return fmt.Errorf("failed to apply network policy: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Outbound traffic denied for '%s'", appName))
+		for _, target := range policyAllowTo {
+			pterm.Info.Println(fmt.Sprintf("Allowed: %s", target))
+		}
+		return nil
+	},
+}
+
+func init() {
+	networkPolicyCmd.Flags().BoolVar(&policyDenyEgress, "deny-egress", false, "Block outbound connections")
+	networkPolicyCmd.Flags().BoolVar(&policyAllowEgress, "allow-egress", false, "Remove the policy and rejoin the previous networks")
+	networkPolicyCmd.Flags().StringArrayVar(&policyAllowTo, "allow-egress-to", []string{}, "Container, application or CIDR that stays reachable (repeatable)")
+}
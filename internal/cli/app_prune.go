@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pruneStatuses []string
+	pruneYes      bool
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove all stopped or failed applications",
+	Long: `Remove every application matching the given status along with its container.
+Named volumes used by the removed applications are preserved.
+
+Examples:
+  finks app prune
+  finks app prune --status unknown --yes`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, status := range pruneStatuses {
+			switch status {
+			case deployment.StatusStopped, deployment.StatusFailed, deployment.StatusUnknown:
+			default:
+				return fmt.Errorf("invalid --status %q (expected stopped, failed or unknown)", status)
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		apps, err := appManager.ListApps(ctx, deployment.AppFilter{})
+		if err != nil {
+			return fmt.Errorf("failed to list applications: %w", err)
+		}
+
+		var candidates []*deployment.App
+		for _, app := range apps {
+			if slices.Contains(pruneStatuses, app.Status) {
+				candidates = append(candidates, app)
+			}
+		}
+
+		if len(candidates) == 0 {
+			pterm.Info.Println("No applications to prune.")
+			return nil
+		}
+
+		pterm.Info.Println("The following applications will be removed:")
+		for _, app := range candidates {
+			fmt.Printf("  %s %s (%s)\n", getStatusIcon(app.Status), app.Name, app.Status)
+		}
+
+		if !pruneYes {
+			confirmed, _ := pterm.DefaultInteractiveConfirm.Show("Continue?")
+			if !confirmed {
+				pterm.Info.Println("Prune cancelled.")
+				return nil
+			}
+		}
+
+		removed := 0
+		for _, app := range candidates {
+			if err := appManager.RemoveApp(ctx, app.Name, false); err != nil {
+				pterm.Error.Println(fmt.Sprintf("Failed to remove '%s': %v", app.Name, err))
+				continue
+			}
+			removed++
+
+			if named := namedVolumes(app.Volumes); len(named) > 0 {
+				pterm.Warning.Println(fmt.Sprintf("Preserved named volumes of '%s': %s", app.Name, strings.Join(named, ", ")))
+			}
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Pruned %d of %d application(s)", removed, len(candidates)))
+		if removed < len(candidates) {
+			return fmt.Errorf("failed to prune %d application(s)", len(candidates)-removed)
+		}
+		return nil
+	},
+}
+
+// namedVolumes returns the Docker named volumes among volume specs, skipping host bind mounts
+func namedVolumes(volumes []string) []string {
+	var named []string
+	for _, volume := range volumes {
+		source, _, found := strings.Cut(volume, ":")
+		if !found || strings.HasPrefix(source, "/") || strings.HasPrefix(source, ".") || strings.HasPrefix(source, "~") {
+			continue
+		}
+		named = append(named, source)
+	}
+	return named
+}
+
+func init() {
+	pruneCmd.Flags().StringSliceVar(&pruneStatuses, "status", []string{deployment.StatusStopped, deployment.StatusFailed}, "Statuses of applications to prune (stopped, failed, unknown)")
+	pruneCmd.Flags().BoolVarP(&pruneYes, "yes", "y", false, "Do not prompt for confirmation")
+}
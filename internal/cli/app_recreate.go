@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	recreatePull    bool
+	recreateTimeout time.Duration
+)
+
+var recreateCmd = &cobra.Command{
+	Use:   "recreate <app-name>",
+	Short: "Replace an application's container with a fresh one",
+	Long: `Stop and remove an application's container, then run a new one with the same
+image, ports, environment, volumes, networks, labels and restart policy. Unlike
+stop and start, the new container starts from a clean filesystem.
+
+Named volumes are kept; anonymous volumes are removed with the old container.
+A stopped application stays stopped; its new container is started with 'finks app start'.
+
+Examples:
+  finks app recreate my-web
+  finks app recreate my-web --pull --timeout 60s`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute+recreateTimeout)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Recreating application '%s'...", appName))
+
+		if err := appManager.RecreateApp(ctx, appName, recreatePull, recreateTimeout); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to recreate application: %v", err))
+			return fmt.Errorf("failed to recreate application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' recreated successfully!", appName))
+		return nil
+	},
+}
+
+func init() {
+	recreateCmd.Flags().BoolVar(&recreatePull, "pull", false, "Pull the latest version of the image before recreating")
+	recreateCmd.Flags().DurationVar(&recreateTimeout, "timeout", 30*time.Second, "How long to wait for the container to stop before killing it")
+}
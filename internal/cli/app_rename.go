@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var renameCmd = &cobra.Command{
+	Use:   "rename <old-name> <new-name>",
+	Short: "Rename an application",
+	Long: `Rename an application, its container and its Traefik routers and services.
+
+Apps routed through Traefik have their container recreated, since Docker
+labels cannot be changed on an existing container.
+
+Examples:
+  finks app rename my-web web`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldName, newName := args[0], args[1]
+
+		// Recreating a routed container waits for the old one to stop
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		progress := func(step string) {
+			pterm.Info.Println(step)
+		}
+
+		if err := appManager.RenameAppWithProgress(ctx, oldName, newName, progress); err != nil {
+			pterm.Error.Println(fmt.Sprintf("Failed to rename application: %v", err))
+			return fmt.Errorf("failed to rename application: %w", err)
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Application '%s' renamed to '%s'", oldName, newName))
+		return nil
+	},
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var resourcesCmd = &cobra.Command{
+	Use:   "resources <app-name>",
+	Short: "Show CPU and memory usage against the configured limits",
+	Long: `Compare an application's current CPU and memory usage with the limits set on its container.
+
+Examples:
+  finks app resources my-web`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		_, detail, err := appManager.InspectApp(ctx, appName)
+		if err != nil {
+			return fmt.Errorf("failed to inspect application: %w", err)
+		}
+		if detail == nil {
+			return fmt.Errorf("application %s has no container", appName)
+		}
+
+		stats, err := appManager.GetAppStats(ctx, appName)
+		if err != nil {
+			return fmt.Errorf("failed to get application stats: %w", err)
+		}
+
+		// CPUPercent is relative to a single core, so 100% means one full core
+		coresUsed := stats.CPUPercent / 100.0
+		cpuLine := fmt.Sprintf("%.2f cores used / unlimited", coresUsed)
+		if detail.NanoCPUs > 0 {
+			coresLimit := float64(detail.NanoCPUs) / 1e9
+			cpuLine = fmt.Sprintf("%.2f cores used / %.1f cores limit (%.1f%%)", coresUsed, coresLimit, coresUsed/coresLimit*100.0)
+		} else if detail.CPUShares > 0 {
+			cpuLine += fmt.Sprintf(" (cpu shares: %d)", detail.CPUShares)
+		}
+
+		memoryLine := fmt.Sprintf("%s used / unlimited", units.BytesSize(float64(stats.MemoryUsage)))
+		if detail.MemoryLimit > 0 {
+			limit := uint64(detail.MemoryLimit)
+			memoryLine = fmt.Sprintf("%s used / %s limit (%.1f%%)",
+				units.BytesSize(float64(stats.MemoryUsage)), units.BytesSize(float64(limit)), float64(stats.MemoryUsage)/float64(limit)*100.0)
+		}
+
+		fmt.Printf("CPU:    %s\n", cpuLine)
+		fmt.Printf("Memory: %s\n", memoryLine)
+		return nil
+	},
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/bimalpaudels/finks/internal/scheduler"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scheduleCron    string
+	scheduleCommand string
+)
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule <app-name> --cron <spec> --cmd <command>",
+	Short: "Run a command inside an application on a schedule",
+	Long: `Schedule a command to run inside an application's container using a cron
+expression (minute hour day-of-month month day-of-week). The command runs
+through /bin/sh -c.
+
+Scheduled tasks are executed by 'finks scheduler start', which must be running.
+
+Examples:
+  finks app schedule my-db --cron "0 3 * * *" --cmd "/backup.sh"
+  finks app schedule my-web --cron "*/15 * * * *" --cmd "php artisan queue:retry all"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		if _, err := appManager.GetApp(appName); err != nil {
+			return err
+		}
+
+		task, err := scheduler.AddTask(appName, scheduleCron, scheduleCommand)
+		if err != nil {
+			return fmt.Errorf("failed to schedule task: %w", err)
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Scheduled '%s' on '%s' (%s)", task.Command, appName, task.Cron))
+		pterm.Info.Println("Make sure 'finks scheduler start' is running to execute scheduled tasks")
+		return nil
+	},
+}
+
+func init() {
+	scheduleCmd.Flags().StringVar(&scheduleCron, "cron", "", "Cron expression, e.g. \"0 3 * * *\" (required)")
+	scheduleCmd.Flags().StringVar(&scheduleCommand, "cmd", "", "Command to run inside the container (required)")
+	scheduleCmd.MarkFlagRequired("cron")
+	scheduleCmd.MarkFlagRequired("cmd")
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/docker/go-units"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+const appTopInterval = 2 * time.Second
+
+type appTopRow struct {
+	app   *deployment.App
+	stats *docker.ContainerStats
+}
+
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show live resource usage of all applications",
+	Long: `Show a live table of CPU, memory and network usage for every finks-managed application.
+The table refreshes every 2 seconds and is sorted by CPU usage. Press Ctrl+C to exit.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		area, err := pterm.DefaultArea.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start display: %w", err)
+		}
+		defer area.Stop()
+
+		ticker := time.NewTicker(appTopInterval)
+		defer ticker.Stop()
+
+		for {
+			rows, err := collectAppTopRows(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+
+			area.Update(renderAppTopTable(rows))
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+func collectAppTopRows(ctx context.Context) ([]appTopRow, error) {
+	listCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	apps, err := appManager.ListApps(listCtx, deployment.AppFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+
+	rows := make([]appTopRow, len(apps))
+	var wg sync.WaitGroup
+	for i, app := range apps {
+		rows[i].app = app
+		if app.Status != deployment.StatusRunning {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			statsCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			if stats, err := appManager.GetAppStats(statsCtx, name); err == nil {
+				rows[i].stats = stats
+			}
+		}(i, app.Name)
+	}
+	wg.Wait()
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return cpuOf(rows[i]) > cpuOf(rows[j])
+	})
+
+	return rows, nil
+}
+
+func cpuOf(row appTopRow) float64 {
+	if row.stats == nil {
+		return -1
+	}
+	return row.stats.CPUPercent
+}
+
+func renderAppTopTable(rows []appTopRow) string {
+	if len(rows) == 0 {
+		return pterm.Info.Sprint("No applications deployed.")
+	}
+
+	tableData := pterm.TableData{{"APP NAME", "CPU%", "MEM USED", "MEM LIMIT", "NET IN", "NET OUT", "STATUS"}}
+	for _, row := range rows {
+		status := getStatusIcon(row.app.Status) + " " + row.app.Status
+		if row.stats == nil {
+			tableData = append(tableData, []string{row.app.Name, "-", "-", "-", "-", "-", status})
+			continue
+		}
+
+		tableData = append(tableData, []string{
+			row.app.Name,
+			fmt.Sprintf("%.2f%%", row.stats.CPUPercent),
+			units.BytesSize(float64(row.stats.MemoryUsage)),
+			units.BytesSize(float64(row.stats.MemoryLimit)),
+			units.BytesSize(float64(row.stats.NetworkRx)),
+			units.BytesSize(float64(row.stats.NetworkTx)),
+			status,
+		})
+	}
+
+	table, _ := pterm.DefaultTable.WithHasHeader().WithData(tableData).Srender()
+	return table + "\n" + pterm.FgGray.Sprintf("Updated %s · Ctrl+C to exit", time.Now().Format("15:04:05"))
+}
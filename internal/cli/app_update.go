@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	updateImage     string
+	updateRolling   bool
+	updateEnvAdd    []string
+	updateEnvRemove []string
+)
+
+var updateAppCmd = &cobra.Command{
+	Use:     "update <app-name>",
+	Aliases: []string{"update-image"},
+	Short:   "Redeploy an application with a new image or environment",
+	Long: `Redeploy an application with a new image or changed environment variables. The
+rest of its configuration is kept.
+
+Without --env-add or --env-remove, the application's image, or the one given with
+--image, is pulled and the application redeployed with it. By default the new
+container is created before the old one is removed, so a failed update leaves the
+application as it was, and a stopped application stays stopped. Apps that
+publish a host port are briefly down while the containers are swapped. With
+--rolling, the new container is started next to the old one and takes over once
+its health check passes (within 60s), so there is no downtime; Traefik briefly
+routes to both. Rolling updates need
+the application to be routed through Traefik rather than publishing a host port.
+
+With --env-add or --env-remove, the environment is changed and the container
+recreated with the image already on the host, without pulling. The old container
+is only removed once the new one has been created, and a stopped application
+stays stopped. The replaced environment is kept, so 'finks app rollback-env'
+can restore it.
+
+Examples:
+  finks app update my-web
+  finks app update my-web --image ghcr.io/acme/web:1.5 --rolling
+  finks app update my-web --env-add LOG_LEVEL=debug --env-remove LEGACY_MODE`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		if len(updateEnvAdd) > 0 || len(updateEnvRemove) > 0 {
+			if updateImage != "" || updateRolling {
+				return fmt.Errorf("--image and --rolling cannot be combined with --env-add or --env-remove")
+			}
+			return updateAppEnv(appName)
+		}
+
+		app, err := appManager.GetApp(appName)
+		if err != nil {
+			return err
+		}
+		image := updateImage
+		if image == "" {
+			image = app.Image
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Updating '%s' to %s...", appName, image))
+
+		if updateRolling {
+			err = appManager.RollingUpdate(ctx, appName, image)
+		} else {
+			err = appManager.UpdateApp(ctx, appName, image)
+		}
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to update application: %v", err))
+			return fmt.Errorf("failed to update application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' is running %s", appName, image))
+		return nil
+	},
+}
+
+// updateAppEnv applies --env-add and --env-remove and reports which keys changed
+func updateAppEnv(appName string) error {
+	set := make(map[string]string, len(updateEnvAdd))
+	for _, env := range updateEnvAdd {
+		key, value, ok := strings.Cut(env, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid --env-add %q: expected KEY=VALUE", env)
+		}
+		set[key] = value
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Updating environment of '%s'...", appName))
+
+	diff, err := appManager.UpdateAppEnv(ctx, appName, set, updateEnvRemove)
+	if err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to update environment: %v", err))
+		return fmt.Errorf("failed to update environment: %w", err)
+	}
+
+	if diff.Empty() {
+		spinner.Info("Environment unchanged; the container was not recreated")
+		return nil
+	}
+
+	spinner.Success(fmt.Sprintf("Environment of '%s' updated", appName))
+	printEnvDiff(diff)
+	return nil
+}
+
+// printEnvDiff lists the added, changed and removed keys of an environment update
+func printEnvDiff(diff deployment.EnvDiff) {
+	for _, key := range diff.Added {
+		fmt.Println(pterm.FgGreen.Sprint("  + " + key))
+	}
+	for _, key := range diff.Changed {
+		fmt.Println(pterm.FgYellow.Sprint("  ~ " + key))
+	}
+	for _, key := range diff.Removed {
+		fmt.Println(pterm.FgRed.Sprint("  - " + key))
+	}
+}
+
+func init() {
+	updateAppCmd.Flags().StringVar(&updateImage, "image", "", "Image to deploy (default is the application's current image)")
+	updateAppCmd.Flags().BoolVar(&updateRolling, "rolling", false, "Start the new container before removing the old one")
+	updateAppCmd.Flags().StringArrayVar(&updateEnvAdd, "env-add", []string{}, "Set an environment variable (KEY=VALUE), repeatable")
+	updateAppCmd.Flags().StringArrayVar(&updateEnvRemove, "env-remove", []string{}, "Unset an environment variable, repeatable")
+}
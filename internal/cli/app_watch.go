@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/bimalpaudels/finks/internal/watch"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchDir      string
+	watchImage    string
+	watchBuildCmd string
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch <app-name>",
+	Short: "Rebuild and redeploy an application when files change",
+	Long: `Watch a directory and, after each change, build the image, push it to its
+registry and redeploy the application with it. Rapid changes are debounced.
+
+finks pulls images on deploy, so --image must name a registry, such as a local
+registry started with 'docker run -d -p 5000:5000 registry:2'.
+
+Examples:
+  finks app watch my-web --dir ./src --image localhost:5000/my-web:dev
+  finks app watch my-web --image localhost:5000/my-web:dev --build-cmd "make docker-build"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		if !hasRegistry(watchImage) {
+			return fmt.Errorf("--image must include a registry host, e.g. localhost:5000/%s", watchImage)
+		}
+		if _, err := appManager.GetApp(appName); err != nil {
+			return err
+		}
+		if info, err := os.Stat(watchDir); err != nil || !info.IsDir() {
+			return fmt.Errorf("--dir %s is not a directory", watchDir)
+		}
+
+		client, err := docker.NewClient()
+		if err != nil {
+			return fmt.Errorf("failed to initialize Docker client: %w", err)
+		}
+		defer client.Close()
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		pterm.Info.Println(fmt.Sprintf("Watching %s for changes (Ctrl+C to stop)", watchDir))
+		return watch.Dir(ctx, watchDir, watch.DefaultDebounce, func() {
+			redeployOnChange(ctx, client, appName)
+		})
+	},
+}
+
+// redeployOnChange builds, pushes and redeploys; failures are reported and watching continues
+func redeployOnChange(ctx context.Context, client *docker.Client, appName string) {
+	pterm.Info.Println(fmt.Sprintf("Change detected, building %s...", watchImage))
+
+	if err := buildWatchImage(ctx, client); err != nil {
+		pterm.Error.Println(fmt.Sprintf("Build failed: %v", err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Redeploying '%s'...", appName))
+	if err := appManager.PushAndUpdateApp(ctx, appName, watchImage); err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to redeploy application: %v", err))
+		return
+	}
+	spinner.Success(fmt.Sprintf("Application '%s' redeployed at %s", appName, time.Now().Format("15:04:05")))
+}
+
+// buildWatchImage runs --build-cmd, or builds the watched directory with the Docker API
+func buildWatchImage(ctx context.Context, client *docker.Client) error {
+	if watchBuildCmd != "" {
+		build := exec.CommandContext(ctx, "/bin/sh", "-c", watchBuildCmd)
+		build.Stdout = os.Stdout
+		build.Stderr = os.Stderr
+		return build.Run()
+	}
+
+	_, err := client.BuildImage(ctx, docker.BuildOptions{ContextDir: watchDir, Tag: watchImage}, &buildOutputWriter{})
+	return err
+}
+
+// hasRegistry reports whether an image reference starts with a registry host
+func hasRegistry(image string) bool {
+	host, _, found := strings.Cut(image, "/")
+	return found && (strings.ContainsAny(host, ".:") || host == "localhost")
+}
+
+func init() {
+	watchCmd.Flags().StringVar(&watchDir, "dir", ".", "Directory to watch")
+	watchCmd.Flags().StringVar(&watchImage, "image", "", "Image to build and deploy, including its registry")
+	watchCmd.Flags().StringVar(&watchBuildCmd, "build-cmd", "", "Shell command that builds the image instead of docker build")
+	watchCmd.MarkFlagRequired("image")
+}
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/spf13/cobra"
+)
+
+var completionCmd = &cobra.Command{
+	Use:   "completion",
+	Short: "Generate shell completion scripts",
+	Long: `Generate shell completion scripts for finks.
+
+Bash:
+  $ source <(finks completion bash)
+  # To load completions for each session, execute once:
+  $ finks completion bash > /etc/bash_completion.d/finks
+
+Zsh:
+  # If shell completion is not already enabled, enable it once:
+  $ echo "autoload -U compinit; compinit" >> ~/.zshrc
+  $ finks completion zsh > "${fpath[1]}/_finks"
+
+Fish:
+  $ finks completion fish | source
+  # To load completions for each session, execute once:
+  $ finks completion fish > ~/.config/fish/completions/finks.fish
+
+PowerShell:
+  PS> finks completion powershell | Out-String | Invoke-Expression
+
+To detect the current shell and install the script automatically:
+  $ finks completion install`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var bashCompletionCmd = &cobra.Command{
+	Use:   "bash",
+	Short: "Generate bash completion script",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rootCmd.GenBashCompletionV2(os.Stdout, true)
+	},
+}
+
+var zshCompletionCmd = &cobra.Command{
+	Use:   "zsh",
+	Short: "Generate zsh completion script",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rootCmd.GenZshCompletion(os.Stdout)
+	},
+}
+
+var fishCompletionCmd = &cobra.Command{
+	Use:   "fish",
+	Short: "Generate fish completion script",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rootCmd.GenFishCompletion(os.Stdout, true)
+	},
+}
+
+var powershellCompletionCmd = &cobra.Command{
+	Use:   "powershell",
+	Short: "Generate PowerShell completion script",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rootCmd.GenPowerShellCompletionWithDesc(os.Stdout)
+	},
+}
+
+// completeAppNames completes the first positional argument with deployed app names
+func completeAppNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	manager, err := deployment.NewManager()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	defer manager.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	apps, err := manager.ListApps(ctx, deployment.AppFilter{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	names := make([]string, 0, len(apps))
+	for _, app := range apps {
+		if strings.HasPrefix(app.Name, toComplete) {
+			names = append(names, app.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeNetworkNames completes the first positional argument with finks network names
+func completeNetworkNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, err := docker.NewClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	networks, err := client.ListNetworks(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var names []string
+	for _, net := range filterFinksNetworks(networks) {
+		if strings.HasPrefix(net.Name, toComplete) {
+			names = append(names, net.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	completionCmd.AddCommand(bashCompletionCmd, zshCompletionCmd, fishCompletionCmd, powershellCompletionCmd, installCompletionCmd)
+
+	startCmd.ValidArgsFunction = completeAppNames
+	stopCmd.ValidArgsFunction = completeAppNames
+	pauseCmd.ValidArgsFunction = completeAppNames
+	unpauseCmd.ValidArgsFunction = completeAppNames
+	removeCmd.ValidArgsFunction = completeAppNames
+	logsCmd.ValidArgsFunction = completeAppNames
+	diffCmd.ValidArgsFunction = completeAppNames
+	inspectCmd.ValidArgsFunction = completeAppNames
+	renameCmd.ValidArgsFunction = completeAppNames
+	resourcesCmd.ValidArgsFunction = completeAppNames
+	eventsCmd.ValidArgsFunction = completeAppNames
+	backupCmd.ValidArgsFunction = completeAppNames
+	migrateCmd.ValidArgsFunction = completeAppNames
+	scheduleCmd.ValidArgsFunction = completeAppNames
+	generateComposeCmd.ValidArgsFunction = completeAppNames
+	watchCmd.ValidArgsFunction = completeAppNames
+	networkPolicyCmd.ValidArgsFunction = completeAppNames
+	healthcheckStatusCmd.ValidArgsFunction = completeAppNames
+	updateAppCmd.ValidArgsFunction = completeAppNames
+	lintCmd.ValidArgsFunction = completeAppNames
+	verifyDomainCmd.ValidArgsFunction = completeAppNames
+	recreateCmd.ValidArgsFunction = completeAppNames
+	listEnvCmd.ValidArgsFunction = completeAppNames
+	importEnvCmd.ValidArgsFunction = completeAppNames
+	historyEnvCmd.ValidArgsFunction = completeAppNames
+	rollbackEnvCmd.ValidArgsFunction = completeAppNames
+	copyVolumeCmd.ValidArgsFunction = completeAppNames
+	autorestartCmd.ValidArgsFunction = completeAppNames
+	updateLabelsProxyCmd.ValidArgsFunction = completeAppNames
+	copyMiddlewareProxyCmd.ValidArgsFunction = completeAppNames
+	connectProxyCmd.ValidArgsFunction = completeNetworkNames
+	renameNetworksCmd.ValidArgsFunction = completeNetworkNames
+	inspectNetworksCmd.ValidArgsFunction = completeNetworkNames
+	connectAppNetworksCmd.ValidArgsFunction = completeNetworkNames
+}
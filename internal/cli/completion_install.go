@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var completionDryRun bool
+
+var installCompletionCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the completion script for the current shell",
+	Long: `Detect the current shell from $SHELL and install the finks completion script
+where that shell loads completions from.
+
+Supported shells are bash, zsh and fish. On macOS with Homebrew, the bash script
+is installed under $(brew --prefix)/etc/bash_completion.d.
+
+Examples:
+  finks completion install
+  finks completion install --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		shell := filepath.Base(os.Getenv("SHELL"))
+		if shell == "." || shell == "" {
+			return fmt.Errorf("could not detect the shell: $SHELL is not set")
+		}
+
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return fmt.Errorf("failed to get user home directory: %w", err)
+		}
+
+		var (
+			path   string
+			reload string
+			script bytes.Buffer
+		)
+		switch shell {
+		case "bash":
+			path = filepath.Join(homeDir, ".bash_completion.d", "finks")
+			if prefix := brewPrefix(); prefix != "" {
+				path = filepath.Join(prefix, "etc", "bash_completion.d", "finks")
+			}
+			reload = "source " + path
+			err = rootCmd.GenBashCompletionV2(&script, true)
+		case "zsh":
+			path = filepath.Join(homeDir, ".zsh", "completions", "_finks")
+			reload = "exec zsh"
+			err = rootCmd.GenZshCompletion(&script)
+		case "fish":
+			path = filepath.Join(homeDir, ".config", "fish", "completions", "finks.fish")
+			reload = "source " + path
+			err = rootCmd.GenFishCompletion(&script, true)
+		default:
+			return fmt.Errorf("unsupported shell %q: use 'finks completion <shell>' to generate the script manually", shell)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to generate %s completion script: %w", shell, err)
+		}
+
+		if completionDryRun {
+			pterm.Info.Printf("Would write %s completion script to %s\n", shell, path)
+			return nil
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create completion directory: %w", err)
+		}
+		if err := os.WriteFile(path, script.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write completion script: %w", err)
+		}
+
+		pterm.Success.Printf("Installed %s completion script to %s\n", shell, path)
+		switch {
+		case shell == "bash" && strings.HasPrefix(path, homeDir):
+			pterm.Info.Printf("To load it in every session, add this line to ~/.bashrc:\n  source %s\n", path)
+		case shell == "zsh":
+			pterm.Info.Printf("Make sure ~/.zshrc adds the directory to fpath before compinit runs:\n  fpath=(%s $fpath)\n  autoload -U compinit; compinit\n", filepath.Dir(path))
+		}
+		pterm.Info.Printf("Reload your shell to enable completions now:\n  %s\n", reload)
+		return nil
+	},
+}
+
+// brewPrefix returns the Homebrew prefix on macOS, or "" when Homebrew is not available
+func brewPrefix() string {
+	if runtime.GOOS != "darwin" {
+		return ""
+	}
+	out, err := exec.Command("brew", "--prefix").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func init() {
+	installCompletionCmd.Flags().BoolVar(&completionDryRun, "dry-run", false, "Show where the script would be written without writing it")
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bimalpaudels/finks/internal/config"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var configPath string
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Configuration commands",
+	Long:  `Commands for inspecting the finks configuration.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var showConfigCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the effective configuration",
+	Long: `Show the effective configuration after applying defaults.
+
+The config file is resolved from --config, then the FINKS_CONFIG environment
+variable, then ~/.finks/config.yaml. Sensitive values are masked.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		asJSON, _ := cmd.Flags().GetBool("json")
+
+		path := config.ResolvePath(configPath)
+		cfg, err := config.Load(path)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		redacted := cfg.Redacted()
+
+		var data []byte
+		if asJSON {
+			data, err = json.MarshalIndent(redacted, "", "  ")
+			data = append(data, '\n')
+		} else {
+			data, err = yaml.Marshal(redacted)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to render config: %w", err)
+		}
+
+		// Keep stdout machine-readable by reporting the source on stderr
+		info := pterm.Info.WithWriter(os.Stderr)
+		if path == "" {
+			info.Println("No config file found, showing defaults")
+		} else {
+			info.Println(fmt.Sprintf("Config file: %s", path))
+		}
+		fmt.Print(string(data))
+		return nil
+	},
+}
+
+func init() {
+	configCmd.AddCommand(showConfigCmd)
+
+	showConfigCmd.Flags().Bool("json", false, "Render the configuration as JSON")
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	buildContext   string
+	buildTag       string
+	buildFile      string
+	buildArgValues []string
+)
+
+var imageCmd = &cobra.Command{
+	Use:   "image",
+	Short: "Manage Docker images",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var buildImageCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build an image from a local directory",
+	Long: `Build a Docker image from a local build context using the Docker API.
+
+Examples:
+  finks image build --context . --tag my-web:latest
+  finks image build --context ./web --file Dockerfile.prod --tag my-web:prod --build-arg VERSION=1.2.0`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		buildArgs, err := parseBuildArgs(buildArgValues)
+		if err != nil {
+			return err
+		}
+
+		client, err := docker.NewClient()
+		if err != nil {
+			return fmt.Errorf("failed to initialize Docker client: %w", err)
+		}
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancel()
+
+		imageID, err := client.BuildImage(ctx, docker.BuildOptions{
+			ContextDir: buildContext,
+			Dockerfile: buildFile,
+			Tag:        buildTag,
+			BuildArgs:  buildArgs,
+		}, &buildOutputWriter{})
+		if err != nil {
+			pterm.Error.Println(fmt.Sprintf("Failed to build image: %v", err))
+			return fmt.Errorf("failed to build image: %w", err)
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Built %s (%s)", buildTag, valueOrDefault(imageID, "unknown ID")))
+		return nil
+	},
+}
+
+// parseBuildArgs turns KEY=VALUE --build-arg values into a map
+func parseBuildArgs(values []string) (map[string]string, error) {
+	args := make(map[string]string, len(values))
+	for _, value := range values {
+		key, val, ok := strings.Cut(value, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --build-arg %q: expected KEY=VALUE", value)
+		}
+		args[key] = val
+	}
+	return args, nil
+}
+
+// buildOutputWriter prints build output line by line in a muted color
+type buildOutputWriter struct {
+	pending []byte
+}
+
+func (w *buildOutputWriter) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if line := strings.TrimRight(string(w.pending[:i]), "\r"); line != "" {
+			pterm.FgGray.Println(line)
+		}
+		w.pending = w.pending[i+1:]
+	}
+}
+
+func init() {
+	imageCmd.AddCommand(buildImageCmd)
+
+	buildImageCmd.Flags().StringVar(&buildContext, "context", ".", "Build context directory")
+	buildImageCmd.Flags().StringVarP(&buildFile, "file", "f", "Dockerfile", "Dockerfile path relative to the context")
+	buildImageCmd.Flags().StringVarP(&buildTag, "tag", "t", "", "Name and tag for the image")
+	buildImageCmd.Flags().StringArrayVar(&buildArgValues, "build-arg", []string{}, "Build-time variable as KEY=VALUE (repeatable)")
+	buildImageCmd.MarkFlagRequired("tag")
+}
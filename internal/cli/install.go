@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"github.com/bimalpaudels/finks/internal/installer"
+	"github.com/spf13/cobra"
+)
+
+var installOnly []string
+
+var installCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Check and install finks dependencies",
+	Long: `Run the installation wizard, which checks each dependency and offers to
+install any that are missing. This is the same wizard that runs when finks is
+started without arguments.
+
+By default only Docker is checked. --only limits the wizard to the named
+requirements and also accepts git and curl, which are not checked otherwise.
+
+Examples:
+  finks install
+  finks install --only docker,git`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return installer.Run(installOnly...)
+	},
+}
+
+func init() {
+	installCmd.Flags().StringSliceVar(&installOnly, "only", []string{}, "Comma-separated requirements to check (e.g. docker,git)")
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// rotatingLogFile appends timestamped log lines to a file, rotating it to
+// <path>.1 once it grows beyond maxSize bytes (0 disables rotation)
+type rotatingLogFile struct {
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+func openRotatingLogFile(path string, maxSize int64) (*rotatingLogFile, error) {
+	f := &rotatingLogFile{path: path, maxSize: maxSize}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingLogFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file %s: %w", f.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat log file %s: %w", f.path, err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// WriteLine writes a single line prefixed with the current time and stream name
+func (f *rotatingLogFile) WriteLine(line logLine) error {
+	entry := fmt.Sprintf("%s [%s] %s\n", time.Now().Format(time.RFC3339), line.stream, line.text)
+	if line.separator {
+		entry = "--\n"
+	}
+
+	if f.maxSize > 0 && f.size+int64(len(entry)) > f.maxSize && f.size > 0 {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := f.file.WriteString(entry)
+	f.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write log file %s: %w", f.path, err)
+	}
+	return nil
+}
+
+func (f *rotatingLogFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file %s: %w", f.path, err)
+	}
+	if err := os.Rename(f.path, f.path+".1"); err != nil {
+		return fmt.Errorf("failed to rotate log file %s: %w", f.path, err)
+	}
+	return f.open()
+}
+
+func (f *rotatingLogFile) Close() error {
+	return f.file.Close()
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+var (
+	logLevel     string
+	logFormat    string
+	outputFormat string
+)
+
+// logLevelOff silences the logger when no --log-level is given, keeping interactive output clean
+const logLevelOff = slog.Level(12)
+
+// initLogging configures the global logger once flags have been parsed
+func initLogging() {
+	level, err := parseLogLevel(logLevel)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		level = slog.LevelInfo
+	}
+	if logLevel == "" && strings.EqualFold(logFormat, "json") {
+		level = slog.LevelInfo
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch strings.ToLower(logFormat) {
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	default:
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	}
+
+	slog.SetDefault(slog.New(handler))
+
+	// In fully structured mode only JSON logs should reach the terminal
+	if strings.EqualFold(logFormat, "json") && strings.EqualFold(outputFormat, "json") {
+		pterm.DisableOutput()
+	}
+}
+
+func parseLogLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "":
+		return logLevelOff, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q, using info", level)
+	}
+}
@@ -2,7 +2,11 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,8 +39,20 @@ var networkCmd = &cobra.Command{
 var listNetworksCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all Docker networks",
-	Long:  `List all Docker networks with their details including name, driver, and subnet information.`,
+	Long: `List all Docker networks with their details including name, driver, and subnet information.
+
+By default only finks-managed networks are shown. Use --all to include
+bridge, host, none and networks created outside of finks.
+
+The CONTAINERS column counts the containers attached to each network. Use
+--output json for scripting.
+
+Examples:
+  finks network list --all
+  finks network list --output json | jq -r '.[] | select(.container_count == 0) | .name'`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		showAll, _ := cmd.Flags().GetBool("all")
+
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
@@ -45,8 +61,19 @@ var listNetworksCmd = &cobra.Command{
 			return fmt.Errorf("failed to list networks: %w", err)
 		}
 
-		filteredNetworks := filterFinksNetworks(networks)
-		formatNetworkTable(filteredNetworks)
+		if !showAll {
+			networks = filterFinksNetworks(networks)
+		}
+
+		if strings.EqualFold(outputFormat, "json") {
+			data, err := json.MarshalIndent(networks, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode networks: %w", err)
+			}
+			fmt.Println(string(data))
+			return nil
+		}
+		formatNetworkTable(networks)
 		return nil
 	},
 }
@@ -54,8 +81,15 @@ var listNetworksCmd = &cobra.Command{
 var createNetworkCmd = &cobra.Command{
 	Use:   "create <network-name>",
 	Short: "Create a new Docker network",
-	Long:  `Create a new Docker network with the specified name and optional driver.`,
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Create a new Docker network with the specified name and optional driver.
+
+The network is labelled finks.managed=true. Add your own labels with --label so
+that tools using Docker label selectors can find it.
+
+Examples:
+  finks network create backend
+  finks network create backend --label team=payments --label monitoring=enabled`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var networkName string
 		if len(args) == 0 {
@@ -65,13 +99,44 @@ var createNetworkCmd = &cobra.Command{
 		}
 
 		driver, _ := cmd.Flags().GetString("driver")
+		enableIPv6, _ := cmd.Flags().GetBool("ipv6")
+		ipv6Subnet, _ := cmd.Flags().GetString("ipv6-subnet")
+		ipv6Gateway, _ := cmd.Flags().GetString("ipv6-gateway")
+		labelArgs, _ := cmd.Flags().GetStringArray("label")
+
+		labels, err := parseNetworkLabels(labelArgs)
+		if err != nil {
+			return err
+		}
+
+		if !enableIPv6 && (ipv6Subnet != "" || ipv6Gateway != "") {
+			return fmt.Errorf("--ipv6-subnet and --ipv6-gateway require --ipv6")
+		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
+		opts := docker.NetworkCreateOptions{
+			Driver:      driver,
+			EnableIPv6:  enableIPv6,
+			IPv6Subnet:  ipv6Subnet,
+			IPv6Gateway: ipv6Gateway,
+			Labels:      labels,
+		}
+
+		if enableIPv6 {
+			if supported, err := dockerClient.SupportsIPv6(ctx); err == nil && !supported {
+				pterm.Warning.Println("Docker daemon may not support IPv6 networks; creation might fail")
+			}
+			if opts.IPv6Subnet == "" {
+				opts.IPv6Subnet = generateIPv6Subnet(networkName)
+				pterm.Info.Println(fmt.Sprintf("Using auto-assigned IPv6 subnet %s", opts.IPv6Subnet))
+			}
+		}
+
 		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Creating network '%s' with driver '%s'...", networkName, driver))
 
-		networkID, err := dockerClient.CreateNetwork(ctx, networkName, driver, nil)
+		networkID, err := dockerClient.CreateNetwork(ctx, networkName, opts)
 		if err != nil {
 			spinner.Fail(fmt.Sprintf("Failed to create network: %v", err))
 			return fmt.Errorf("failed to create network: %w", err)
@@ -83,6 +148,46 @@ var createNetworkCmd = &cobra.Command{
 	},
 }
 
+// parseNetworkLabels parses KEY=VALUE labels and merges them with the finks defaults,
+// which they may not override
+func parseNetworkLabels(args []string) (map[string]string, error) {
+	parsed, err := parseLabelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := docker.DefaultNetworkLabels()
+	for key, value := range parsed {
+		if _, reserved := labels[key]; reserved {
+			return nil, fmt.Errorf("label %s is set by finks and cannot be overridden", key)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// userLabels formats the labels of a network other than the finks defaults as key=value pairs
+func userLabels(labels map[string]string) []string {
+	defaults := docker.DefaultNetworkLabels()
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		if _, ok := defaults[key]; !ok {
+			pairs = append(pairs, key+"="+value)
+		}
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// generateIPv6Subnet derives a stable unique local /80 subnet within fd00::/8 from the network name
+func generateIPv6Subnet(networkName string) string {
+	h := fnv.New64a()
+	h.Write([]byte(networkName))
+	sum := h.Sum64()
+	return fmt.Sprintf("fd00:%x:%x:%x:%x::/80",
+		uint16(sum>>48), uint16(sum>>32), uint16(sum>>16), uint16(sum))
+}
+
 func valueOrDefault(value, placeholder string) string {
 	if value == "" {
 		return placeholder
@@ -97,7 +202,7 @@ func formatNetworkTable(networks []docker.NetworkInfo) {
 	}
 
 	tableData := make(pterm.TableData, 1, len(networks)+1)
-	tableData[0] = []string{"NAME", "NETWORK ID", "DRIVER", "SUBNET", "GATEWAY"}
+	tableData[0] = []string{"NAME", "NETWORK ID", "DRIVER", "SUBNET", "GATEWAY", "CONTAINERS", "MANAGED", "LABELS"}
 
 	for _, net := range networks {
 		networkID := net.ID
@@ -111,12 +216,23 @@ func formatNetworkTable(networks []docker.NetworkInfo) {
 			net.Driver,
 			valueOrDefault(net.Subnet, "-"),
 			valueOrDefault(net.Gateway, "-"),
+			strconv.Itoa(net.ContainerCount),
+			managedMarker(net.Name),
+			valueOrDefault(strings.Join(userLabels(net.Labels), ","), "-"),
 		})
 	}
 
 	pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
 }
 
+// managedMarker reports whether a network was created by finks
+func managedMarker(name string) string {
+	if strings.HasPrefix(name, finksNetworkPrefix) {
+		return "✓"
+	}
+	return "✗"
+}
+
 func filterFinksNetworks(networks []docker.NetworkInfo) []docker.NetworkInfo {
 	filteredNetworks := make([]docker.NetworkInfo, 0, len(networks))
 	for _, net := range networks {
@@ -128,9 +244,15 @@ func filterFinksNetworks(networks []docker.NetworkInfo) []docker.NetworkInfo {
 }
 
 func init() {
-	networkCmd.AddCommand(listNetworksCmd, createNetworkCmd)
+	networkCmd.AddCommand(listNetworksCmd, createNetworkCmd, inspectNetworksCmd, gatewayNetworksCmd, statsNetworksCmd, renameNetworksCmd, connectAppNetworksCmd)
+
+	listNetworksCmd.Flags().BoolP("all", "a", false, "Show all Docker networks, not just finks-managed ones")
 
 	// Add flags for create command
 	createNetworkCmd.Flags().StringP("driver", "d", "bridge", "Network driver (bridge, overlay, etc.)")
+	createNetworkCmd.Flags().Bool("ipv6", false, "Enable IPv6 (dual-stack) networking")
+	createNetworkCmd.Flags().String("ipv6-subnet", "", "IPv6 subnet in CIDR format (auto-assigned from fd00::/80 if omitted)")
+	createNetworkCmd.Flags().String("ipv6-gateway", "", "IPv6 gateway address")
+	createNetworkCmd.Flags().StringArray("label", []string{}, "Add a label to the network as KEY=VALUE (repeatable)")
 
 }
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var connectAppNetworksCmd = &cobra.Command{
+	Use:   "connect-app <network-name> <app-name>",
+	Short: "Connect an application to a network",
+	Long: `Connect a deployed application's container to a Docker network. This is the
+same as 'finks app connect <app-name> <network-name>' with the arguments the
+other way round.
+
+Examples:
+  finks network connect-app finks-backend my-web`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		networkName, appName := args[0], args[1]
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Connecting application '%s' to network '%s'...", appName, networkName))
+
+		if err := manager.AddNetwork(ctx, appName, networkName); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to connect application: %v", err))
+			return fmt.Errorf("failed to connect application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' connected to network '%s'!", appName, networkName))
+		return nil
+	},
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// traefikContainer is the proxy container, shown separately from the app matrix
+const traefikContainer = "finks-traefik"
+
+var gatewayNetworksCmd = &cobra.Command{
+	Use:   "gateway",
+	Short: "Show which containers can reach each other",
+	Long: `Show a connectivity matrix of all containers on finks networks. Two containers
+can reach each other (✓) when they share at least one network. The Traefik proxy
+is listed separately with the networks it routes on.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		networks, err := dockerClient.ListNetworks(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list networks: %w", err)
+		}
+
+		// memberships maps a container to the finks networks it is attached to
+		memberships := make(map[string]map[string]bool)
+		ips := make(map[string][]string)
+		for _, net := range filterFinksNetworks(networks) {
+			info, err := dockerClient.GetNetworkInfo(ctx, net.Name)
+			if err != nil {
+				return fmt.Errorf("failed to inspect network %s: %w", net.Name, err)
+			}
+			for _, c := range info.Containers {
+				if memberships[c.Name] == nil {
+					memberships[c.Name] = make(map[string]bool)
+				}
+				memberships[c.Name][net.Name] = true
+				ips[c.Name] = append(ips[c.Name], fmt.Sprintf("%s=%s", net.Name, valueOrDefault(c.IPv4, "-")))
+			}
+		}
+
+		var containers []string
+		for name := range memberships {
+			if name != traefikContainer {
+				containers = append(containers, name)
+			}
+		}
+		sort.Strings(containers)
+
+		if len(containers) == 0 {
+			pterm.Warning.Println("No containers are attached to finks networks.")
+			return nil
+		}
+
+		tableData := pterm.TableData{append([]string{""}, containers...)}
+		for _, row := range containers {
+			cells := []string{row}
+			for _, col := range containers {
+				switch {
+				case row == col:
+					cells = append(cells, "-")
+				case sharesNetwork(memberships[row], memberships[col]):
+					cells = append(cells, pterm.FgGreen.Sprint("✓"))
+				default:
+					cells = append(cells, pterm.FgRed.Sprint("✗"))
+				}
+			}
+			tableData = append(tableData, cells)
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+
+		fmt.Println()
+		addresses := pterm.TableData{{"CONTAINER", "ADDRESSES"}}
+		for _, name := range containers {
+			addresses = append(addresses, []string{name, strings.Join(ips[name], ", ")})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(addresses).Render()
+
+		fmt.Println()
+		proxyNetworks := memberships[traefikContainer]
+		if len(proxyNetworks) == 0 {
+			pterm.Warning.Println("Proxy: Traefik is not attached to any finks network")
+			return nil
+		}
+
+		var routable []string
+		for _, name := range containers {
+			if sharesNetwork(proxyNetworks, memberships[name]) {
+				routable = append(routable, name)
+			}
+		}
+		var proxyAddresses []string
+		for _, networkName := range sortedKeys(proxyNetworks) {
+			ip, err := dockerClient.GetContainerIP(ctx, traefikContainer, networkName)
+			if err != nil && !errors.Is(err, docker.ErrContainerNotOnNetwork) {
+				return fmt.Errorf("failed to get Traefik address: %w", err)
+			}
+			proxyAddresses = append(proxyAddresses, fmt.Sprintf("%s=%s", networkName, valueOrDefault(ip, "-")))
+		}
+		pterm.Info.Println(fmt.Sprintf("Proxy: Traefik is on %s", strings.Join(proxyAddresses, ", ")))
+		pterm.Info.Println(fmt.Sprintf("Proxy: can route to %s", valueOrDefault(strings.Join(routable, ", "), "no containers")))
+		return nil
+	},
+}
+
+func sharesNetwork(a, b map[string]bool) bool {
+	for name := range a {
+		if b[name] {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
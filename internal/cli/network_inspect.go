@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var inspectNetworksCmd = &cobra.Command{
+	Use:   "inspect <network-name>",
+	Short: "Show details of a Docker network",
+	Long: `Show the driver, subnet, labels and attached containers of a network.
+
+Examples:
+  finks network inspect finks-backend`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		info, err := dockerClient.GetNetworkInfo(ctx, args[0])
+		if err != nil {
+			return fmt.Errorf("failed to inspect network: %w", err)
+		}
+
+		tableData := pterm.TableData{
+			{"Name", info.Name},
+			{"ID", info.ID},
+			{"Driver", info.Driver},
+			{"Subnet", valueOrDefault(info.Subnet, "-")},
+			{"Gateway", valueOrDefault(info.Gateway, "-")},
+			{"Managed", managedMarker(info.Name)},
+			{"Labels", valueOrDefault(strings.Join(userLabels(info.Labels), ", "), "-")},
+		}
+		pterm.DefaultTable.WithData(tableData).Render()
+
+		if len(info.Containers) == 0 {
+			pterm.Info.Println("No containers attached.")
+			return nil
+		}
+
+		containers := pterm.TableData{{"CONTAINER", "IPV4"}}
+		for _, c := range info.Containers {
+			containers = append(containers, []string{c.Name, valueOrDefault(c.IPv4, "-")})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(containers).Render()
+		return nil
+	},
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var renameNetworksCmd = &cobra.Command{
+	Use:   "rename <old-name> <new-name>",
+	Short: "Rename a Docker network",
+	Long: `Rename a network, for example when its name conflicts with existing Docker
+infrastructure. Docker cannot rename networks, so the network is recreated under
+the new name with the same driver, subnet and gateway, and every container attached
+to it is moved over, keeping its aliases and static addresses. Applications that
+reference the old name are updated, and apps whose traefik.docker.network label
+points at it are recreated with the new name so Traefik keeps routing to them.
+
+If moving the network fails, the old network is restored and its containers are
+reconnected.
+
+The finks-default and finks-traefik networks cannot be renamed: Traefik is created
+on finks-traefik, and the labels finks generates always route apps through
+finks-default.
+
+Examples:
+  finks network rename finks-old finks-new`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldName, newName := args[0], args[1]
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Renaming network '%s' to '%s'...", oldName, newName))
+
+		result, err := manager.RenameNetwork(ctx, oldName, newName)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to rename network: %v", err))
+			return fmt.Errorf("failed to rename network: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Network '%s' renamed to '%s'!", oldName, newName))
+		if len(result.Moved) > 0 {
+			pterm.Info.Println(fmt.Sprintf("Reconnected containers: %s", strings.Join(result.Moved, ", ")))
+		}
+		if len(result.Relabeled) > 0 {
+			pterm.Info.Println(fmt.Sprintf("Updated Traefik labels: %s", strings.Join(result.Relabeled, ", ")))
+		}
+		if !strings.HasPrefix(newName, finksNetworkPrefix) {
+			pterm.Warning.Println(fmt.Sprintf("'%s' does not start with %s, so 'finks network list' only shows it with --all", newName, finksNetworkPrefix))
+		}
+		return nil
+	},
+}
@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/docker/go-units"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var networkStatsInterval time.Duration
+
+var statsNetworksCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show live traffic per finks network",
+	Long: `Show the traffic rate of each finks network, refreshed every --interval.
+
+Docker only reports traffic per container, so a network's traffic is the sum of
+the traffic of its containers. A container attached to several networks counts
+toward each of them. Rates appear from the second sample on.
+
+Examples:
+  finks network stats
+  finks network stats --interval 5s`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if networkStatsInterval < time.Second {
+			return fmt.Errorf("--interval must be at least 1s")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		area, err := pterm.DefaultArea.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start display: %w", err)
+		}
+		defer area.Stop()
+
+		ticker := time.NewTicker(networkStatsInterval)
+		defer ticker.Stop()
+
+		var previous map[string]docker.NetworkCounters
+		var previousAt time.Time
+		for {
+			sampledAt := time.Now()
+			networks, counters, err := sampleNetworkTraffic(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+
+			table, err := renderNetworkStats(networks, counters, previous, sampledAt.Sub(previousAt).Seconds())
+			if err != nil {
+				return err
+			}
+			area.Update(table)
+			previous, previousAt = counters, sampledAt
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+// sampleNetworkTraffic returns the containers of each finks network and the current
+// traffic counters of every container on them
+func sampleNetworkTraffic(ctx context.Context) (map[string][]string, map[string]docker.NetworkCounters, error) {
+	list, err := dockerClient.ListNetworks(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	networks := make(map[string][]string)
+	counters := make(map[string]docker.NetworkCounters)
+	for _, net := range filterFinksNetworks(list) {
+		info, err := dockerClient.GetNetworkInfo(ctx, net.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		networks[net.Name] = []string{}
+		for _, c := range info.Containers {
+			networks[net.Name] = append(networks[net.Name], c.Name)
+			if _, ok := counters[c.Name]; ok {
+				continue
+			}
+			sample, err := dockerClient.GetContainerNetworkCounters(ctx, c.Name)
+			if err != nil {
+				// The container may have stopped since the network was inspected
+				continue
+			}
+			counters[c.Name] = *sample
+		}
+	}
+	return networks, counters, nil
+}
+
+// renderNetworkStats renders per-network rates from two samples of container counters
+func renderNetworkStats(networks map[string][]string, current, previous map[string]docker.NetworkCounters, elapsed float64) (string, error) {
+	if len(networks) == 0 {
+		return pterm.Info.Sprintln("No finks networks found"), nil
+	}
+
+	names := make([]string, 0, len(networks))
+	for name := range networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tableData := pterm.TableData{{"NETWORK", "CONTAINERS", "BYTES_IN", "BYTES_OUT", "PACKETS_IN", "PACKETS_OUT"}}
+	for _, name := range names {
+		row := []string{name, strconv.Itoa(len(networks[name])), "-", "-", "-", "-"}
+		if previous != nil && elapsed > 0 {
+			var delta docker.NetworkCounters
+			for _, container := range networks[name] {
+				now, ok := current[container]
+				before, seen := previous[container]
+				if !ok || !seen {
+					continue
+				}
+				delta.RxBytes += counterDelta(now.RxBytes, before.RxBytes)
+				delta.TxBytes += counterDelta(now.TxBytes, before.TxBytes)
+				delta.RxPackets += counterDelta(now.RxPackets, before.RxPackets)
+				delta.TxPackets += counterDelta(now.TxPackets, before.TxPackets)
+			}
+			row[2] = units.BytesSize(float64(delta.RxBytes)/elapsed) + "/s"
+			row[3] = units.BytesSize(float64(delta.TxBytes)/elapsed) + "/s"
+			row[4] = fmt.Sprintf("%.0f/s", float64(delta.RxPackets)/elapsed)
+			row[5] = fmt.Sprintf("%.0f/s", float64(delta.TxPackets)/elapsed)
+		}
+		tableData = append(tableData, row)
+	}
+
+	table, err := pterm.DefaultTable.WithHasHeader().WithData(tableData).Srender()
+	if err != nil {
+		return "", fmt.Errorf("failed to render table: %w", err)
+	}
+	return table + "\n" + pterm.FgGray.Sprintf("Updated %s · Ctrl+C to exit", time.Now().Format("15:04:05")) + "\n", nil
+}
+
+// counterDelta returns the increase of a counter, treating a reset (e.g. a restarted container) as no traffic
+func counterDelta(current, previous uint64) uint64 {
+	if current < previous {
+		return 0
+	}
+	return current - previous
+}
+
+func init() {
+	statsNetworksCmd.Flags().DurationVarP(&networkStatsInterval, "interval", "i", 2*time.Second, "Refresh interval")
+}
@@ -0,0 +1,249 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	manifestPath  string
+	initName      string
+	initImage     string
+	initOverwrite bool
+	upDetach      bool
+	downVolumes   bool
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create a finks.yml manifest in the current directory",
+	Long: `Create a finks.yml application manifest in the current directory. The manifest
+lists the image, port, environment, volumes, networks and Traefik routing of the
+project's application, so its deployment can be version-controlled with its code.
+
+The application name defaults to the directory name.
+
+Examples:
+  finks init
+  finks init --name my-web --image ghcr.io/acme/web:1.4`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := os.Stat(deployment.ManifestFile); err == nil && !initOverwrite {
+			return fmt.Errorf("%s already exists, pass --force to overwrite it", deployment.ManifestFile)
+		}
+
+		name := initName
+		if name == "" {
+			dir, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+			name = strings.ToLower(strings.ReplaceAll(filepath.Base(dir), " ", "-"))
+		}
+
+		manifest := deployment.ManifestTemplate(name, initImage)
+		if err := os.WriteFile(deployment.ManifestFile, manifest, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", deployment.ManifestFile, err)
+		}
+
+		pterm.Success.Printf("Created %s for application '%s'\n", deployment.ManifestFile, name)
+		pterm.Info.Println("Edit it, then run 'finks up' to deploy")
+		return nil
+	},
+}
+
+var upCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Deploy the application described by finks.yml",
+	Long: `Deploy the application described by the finks.yml manifest in the current
+directory, or the manifest given with --file. The image is pulled and the
+container started. With --detach=false the application's logs are streamed
+until Ctrl+C; the application keeps running afterwards.
+
+Examples:
+  finks up
+  finks up --detach=false
+  finks up --file deploy/finks.yml`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		manifest, err := deployment.LoadManifest(manifestPath)
+		if err != nil {
+			return err
+		}
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		if _, err := manager.GetApp(manifest.Name); err == nil {
+			return fmt.Errorf("application %s is already deployed, run 'finks down' first to redeploy it", manifest.Name)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		opts := manifest.DeployOptions()
+		warnSecurityHeadersWithoutTLS(opts)
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Deploying application '%s' from image '%s'...", manifest.Name, manifest.Image))
+
+		if err := manager.DeployApp(ctx, opts); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to deploy application: %v", err))
+			return fmt.Errorf("failed to deploy application: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' deployed successfully!", manifest.Name))
+		if opts.Domain != "" {
+			scheme := "https"
+			if opts.LocalMode {
+				scheme = "http"
+			}
+			pterm.Info.Println(fmt.Sprintf("Routed through Traefik at: %s://%s", scheme, opts.Domain))
+		} else if opts.Port != "" {
+			pterm.Info.Println(fmt.Sprintf("Available at: http://localhost:%s", strings.Split(opts.Port, ":")[0]))
+		}
+
+		if upDetach {
+			return nil
+		}
+		return followAppLogs(manager, manifest.Name)
+	},
+}
+
+// followAppLogs streams an application's logs to the terminal until Ctrl+C
+func followAppLogs(manager *deployment.Manager, name string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pterm.Info.Println("Streaming logs, press Ctrl+C to stop")
+
+	stdout := newLogLineWriter(logStreamStdout, printLogLine)
+	stderr := newLogLineWriter(logStreamStderr, printLogLine)
+	err := manager.StreamAppLogs(ctx, name, docker.LogOptions{Follow: true, Tail: "all"}, stdout, stderr)
+	stdout.Flush()
+	stderr.Flush()
+	if err != nil {
+		return fmt.Errorf("failed to get application logs: %w", err)
+	}
+	return nil
+}
+
+var downCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Remove the application described by finks.yml",
+	Long: `Stop and remove the application described by the finks.yml manifest in the
+current directory, or the manifest given with --file. With --volumes, the named
+volumes listed in the manifest are removed as well; bind mounts are left alone.
+
+Examples:
+  finks down
+  finks down --volumes`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		manifest, err := deployment.LoadManifest(manifestPath)
+		if err != nil {
+			return err
+		}
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Removing application '%s'...", manifest.Name))
+
+		if err := manager.RemoveApp(ctx, manifest.Name, true); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to remove application: %v", err))
+			return fmt.Errorf("failed to remove application: %w", err)
+		}
+
+		if downVolumes {
+			volumes := manifest.NamedVolumes()
+			if err := manager.RemoveVolumes(ctx, volumes); err != nil {
+				spinner.Fail(fmt.Sprintf("Application removed, but failed to remove volumes: %v", err))
+				return fmt.Errorf("failed to remove volumes: %w", err)
+			}
+			spinner.Success(fmt.Sprintf("Application '%s' and %d volume(s) removed", manifest.Name, len(volumes)))
+			return nil
+		}
+
+		spinner.Success(fmt.Sprintf("Application '%s' removed", manifest.Name))
+		return nil
+	},
+}
+
+var psCmd = &cobra.Command{
+	Use:   "ps",
+	Short: "Show the application described by finks.yml",
+	Long: `Show the status of the application described by the finks.yml manifest in the
+current directory, or the manifest given with --file.
+
+Examples:
+  finks ps`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		manifest, err := deployment.LoadManifest(manifestPath)
+		if err != nil {
+			return err
+		}
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		apps, err := manager.ListApps(ctx, deployment.AppFilter{})
+		if err != nil {
+			return fmt.Errorf("failed to list applications: %w", err)
+		}
+
+		var project []*deployment.App
+		for _, app := range apps {
+			if app.Name == manifest.Name {
+				project = append(project, app)
+			}
+		}
+
+		if len(project) == 0 {
+			pterm.Info.Printf("Application '%s' is not deployed, run 'finks up' to deploy it\n", manifest.Name)
+			return nil
+		}
+
+		renderAppTable(project)
+		return nil
+	},
+}
+
+func init() {
+	initCmd.Flags().StringVar(&initName, "name", "", "Application name (default is the directory name)")
+	initCmd.Flags().StringVar(&initImage, "image", "", "Image to deploy")
+	initCmd.Flags().BoolVar(&initOverwrite, "force", false, "Overwrite an existing finks.yml")
+
+	upCmd.Flags().BoolVarP(&upDetach, "detach", "d", true, "Return once the application is started instead of streaming its logs")
+	downCmd.Flags().BoolVar(&downVolumes, "volumes", false, "Also remove the named volumes listed in the manifest")
+
+	for _, cmd := range []*cobra.Command{upCmd, downCmd, psCmd} {
+		cmd.Flags().StringVarP(&manifestPath, "file", "f", deployment.ManifestFile, "Path to the manifest")
+	}
+}
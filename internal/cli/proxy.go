@@ -2,13 +2,21 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/bimalpaudels/finks/internal/deployment"
 	"github.com/bimalpaudels/finks/internal/docker"
 	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/bimalpaudels/finks/internal/version"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var proxyDockerClient *docker.Client
@@ -32,8 +40,64 @@ var proxyCmd = &cobra.Command{
 var installProxyCmd = &cobra.Command{
 	Use:   "install",
 	Short: "Install Traefik proxy container",
-	Long:  `Install and configure Traefik proxy container with proper networking setup.`,
+	Long: `Install and configure Traefik proxy container with proper networking setup.
+
+Use --config-file to mount your own traefik.toml or traefik.yaml static
+configuration instead of the built-in settings. The file is remembered and
+mounted again whenever the container is recreated; pass --config-file ""
+to go back to the built-in settings.
+
+Traefik listens for HTTPS on port 443 unless --local is set, in which case only
+plain HTTP on port 80 is served. Like --config-file, the choice is remembered.
+
+Use --traefik-version to pin the Traefik image tag. The pinned version is
+remembered, and the container is recreated when it changes.
+
+--no-dashboard turns off the Traefik API and dashboard and stops publishing port
+8080. The choice is remembered; pass --no-dashboard=false to enable them again.
+
+Examples:
+  finks proxy install
+  finks proxy install --local
+  finks proxy install --no-dashboard
+  finks proxy install --traefik-version v3.1
+  finks proxy install --config-file ./traefik.yaml`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("local") {
+			local, _ := cmd.Flags().GetBool("local")
+			if err := proxy.SetLocalMode(local); err != nil {
+				return fmt.Errorf("failed to set local mode: %w", err)
+			}
+		}
+
+		if cmd.Flags().Changed("no-dashboard") {
+			noDashboard, _ := cmd.Flags().GetBool("no-dashboard")
+			if err := proxy.SetDashboardEnabled(!noDashboard); err != nil {
+				return fmt.Errorf("failed to set dashboard: %w", err)
+			}
+		}
+
+		if cmd.Flags().Changed("traefik-version") {
+			tag, _ := cmd.Flags().GetString("traefik-version")
+			if err := proxy.SetTraefikVersion(tag); err != nil {
+				return fmt.Errorf("failed to set Traefik version: %w", err)
+			}
+		}
+
+		if cmd.Flags().Changed("config-file") {
+			configFile, _ := cmd.Flags().GetString("config-file")
+
+			cmd.Flags().Visit(func(f *pflag.Flag) {
+				if f.Name != "config-file" && f.Name != "local" && f.Name != "traefik-version" && f.Name != "no-dashboard" {
+					pterm.Warning.Println(fmt.Sprintf("--%s is ignored when --config-file is set", f.Name))
+				}
+			})
+
+			if err := proxy.SetStaticConfigFile(configFile); err != nil {
+				return fmt.Errorf("failed to set Traefik config file: %w", err)
+			}
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 		defer cancel()
 
@@ -45,7 +109,14 @@ var installProxyCmd = &cobra.Command{
 		}
 
 		spinner.Success("Traefik proxy installed successfully!")
-		pterm.Success.Println("Traefik dashboard available at: http://localhost:8080/dashboard/")
+
+		settings, err := proxy.LoadSettings()
+		if err != nil {
+			return err
+		}
+		if settings.DashboardEnabled() {
+			pterm.Success.Println("Traefik dashboard available at: " + proxy.DashboardURL)
+		}
 
 		return nil
 	},
@@ -54,7 +125,9 @@ var installProxyCmd = &cobra.Command{
 var statusProxyCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Check Traefik proxy status",
-	Long:  `Check the status of the Traefik proxy container and network configuration.`,
+	Long: `Check the status of the Traefik proxy container and network configuration.
+When Traefik is running, the number of routers, services, middlewares and
+certificates it has loaded is shown too.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
@@ -70,18 +143,271 @@ var statusProxyCmd = &cobra.Command{
 			return nil
 		}
 
-		pterm.Success.Println(fmt.Sprintf("Traefik container: %s", status.ContainerStatus))
+		renderProxyStatus(status)
+		return nil
+	},
+}
 
-		if status.NetworkExists {
-			pterm.Success.Println("Traefik network: finks-traefik exists")
-		} else {
-			pterm.Warning.Println("Traefik network: finks-traefik does not exist")
+// renderProxyStatus prints the Traefik container, network and routing summary
+func renderProxyStatus(status *proxy.TraefikStatus) {
+	tableData := pterm.TableData{
+		{"Container", status.ContainerStatus},
+		{"Network", traefikNetworkState(status.NetworkExists)},
+	}
+	if status.IsRunning && !status.DashboardEnabled {
+		tableData = append(tableData, []string{"Dashboard", "disabled"})
+	} else if status.IsRunning {
+		tableData = append(tableData, []string{"Dashboard", status.DashboardURL})
+		if status.APIError == nil {
+			tableData = append(tableData,
+				[]string{"HTTP routers", strconv.Itoa(status.RouterCount)},
+				[]string{"HTTP services", strconv.Itoa(status.ServiceCount)},
+				[]string{"Middlewares", strconv.Itoa(status.MiddlewareCount)},
+				[]string{"TLS certificates", strconv.Itoa(status.CertCount)},
+			)
+		}
+	}
+	pterm.DefaultTable.WithData(tableData).Render()
+
+	if !status.IsRunning {
+		pterm.Warning.Println("Traefik is not running; start it with 'finks proxy install'")
+	} else if !status.DashboardEnabled {
+		pterm.Info.Println("The Traefik API is disabled, so routing statistics are unavailable")
+	} else if errors.Is(status.APIError, proxy.ErrAPINotEnabled) {
+		pterm.Warning.Println("Traefik API is not enabled, so routing statistics are unavailable")
+	} else if status.APIError != nil {
+		pterm.Warning.Println(fmt.Sprintf("Could not query the Traefik API: %v", status.APIError))
+	}
+
+	if status.VersionDrift {
+		pterm.Warning.Println(fmt.Sprintf("Traefik was installed by finks %s, running finks %s",
+			status.InstalledVersion, version.Version))
+	} else if status.InstalledVersion == "" {
+		pterm.Info.Println("The finks version that installed Traefik is unknown")
+	}
+}
+
+// traefikNetworkState describes whether the finks-traefik network exists
+func traefikNetworkState(exists bool) string {
+	if exists {
+		return "finks-traefik exists"
+	}
+	return pterm.FgYellow.Sprint("finks-traefik does not exist")
+}
+
+var proxyHealthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "Check Traefik API health",
+	Long: `Query the Traefik API overview endpoint and report enabled providers,
+active routers and services, and any configuration errors.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiURL, _ := cmd.Flags().GetString("url")
+
+		health, err := proxy.CheckTraefikHealth(context.Background(), apiURL)
+		if errors.Is(err, proxy.ErrAPINotEnabled) {
+			pterm.Warning.Println("API not enabled — rerun 'finks proxy install' to enable the Traefik API")
+			return err
+		}
+		if err != nil {
+			pterm.Error.Println(fmt.Sprintf("Traefik API is not healthy: %v", err))
+			return fmt.Errorf("failed to check Traefik health: %w", err)
+		}
+
+		pterm.Success.Println("Traefik API is responding")
+		pterm.Info.Println(fmt.Sprintf("Providers: %s", valueOrDefault(strings.Join(health.Providers, ", "), "-")))
+		pterm.Info.Println(fmt.Sprintf("Routers: %d", health.Routers))
+		pterm.Info.Println(fmt.Sprintf("Services: %d", health.Services))
+
+		if health.Warnings > 0 {
+			pterm.Warning.Println(fmt.Sprintf("Warnings: %d", health.Warnings))
+		}
+		if health.Errors > 0 {
+			pterm.Error.Println(fmt.Sprintf("Errors: %d", health.Errors))
+		}
+		return nil
+	},
+}
+
+var tlsStatusProxyCmd = &cobra.Command{
+	Use:   "tls-status",
+	Short: "Show TLS certificate expiry for routed domains",
+	Long: `Collect every domain from the Host rules of the Traefik routers and connect
+to each on port 443 to read the certificate it presents. No changes are made
+to the Traefik container.
+
+Examples:
+  finks proxy tls-status
+  finks proxy tls-status --url http://localhost:8080`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiURL, _ := cmd.Flags().GetString("url")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		domains, err := proxy.ListRouterDomains(ctx, apiURL)
+		if errors.Is(err, proxy.ErrAPINotEnabled) {
+			pterm.Warning.Println("API not enabled — rerun 'finks proxy install' to enable the Traefik API")
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("failed to list Traefik domains: %w", err)
+		}
+
+		if len(domains) == 0 {
+			pterm.Info.Println("No domains are routed by Traefik")
+			return nil
+		}
+
+		tableData := pterm.TableData{{"DOMAIN", "ISSUER", "EXPIRES", "DAYS_LEFT"}}
+		for _, domain := range domains {
+			status := proxy.CheckCertificate(ctx, domain)
+			if status.Err != nil {
+				tableData = append(tableData, []string{domain, "-", "-", pterm.FgRed.Sprint("unreachable")})
+				continue
+			}
+
+			tableData = append(tableData, []string{
+				domain,
+				valueOrDefault(status.Issuer, "-"),
+				status.NotAfter.Format("2006-01-02"),
+				daysLeftColor(status.DaysLeft).Sprint(status.DaysLeft),
+			})
+		}
+
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+// daysLeftColor highlights certificates that are close to expiry
+func daysLeftColor(days int) pterm.Color {
+	switch {
+	case days < 7:
+		return pterm.FgRed
+	case days < 30:
+		return pterm.FgYellow
+	default:
+		return pterm.FgGreen
+	}
+}
+
+var updateLabelsProxyCmd = &cobra.Command{
+	Use:   "update-labels [app-name]",
+	Short: "Reapply Traefik labels to application containers",
+	Long: `Regenerate the Traefik labels of an application from its stored config and
+compare them with its container. Containers with stale labels are recreated,
+since Docker labels cannot be changed in place.
+
+Examples:
+  finks proxy update-labels my-web --dry-run
+  finks proxy update-labels --all`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if all == (len(args) == 1) {
+			return fmt.Errorf("specify either an application name or --all")
+		}
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		appNames := args
+		if all {
+			apps, err := manager.ListApps(ctx, deployment.AppFilter{})
+			if err != nil {
+				return fmt.Errorf("failed to list applications: %w", err)
+			}
+			appNames = nil
+			for _, app := range apps {
+				if app.Status != deployment.StatusCreated {
+					appNames = append(appNames, app.Name)
+				}
+			}
+		}
+
+		var failed int
+		for _, appName := range appNames {
+			diffs, err := manager.UpdateAppLabels(ctx, appName, dryRun)
+			if err != nil {
+				pterm.Error.Println(fmt.Sprintf("%s: %v", appName, err))
+				failed++
+				continue
+			}
+
+			switch {
+			case len(diffs) == 0:
+				pterm.Success.Println(fmt.Sprintf("%s: labels are up to date", appName))
+			case dryRun:
+				pterm.Info.Println(fmt.Sprintf("%s: %d label(s) would change", appName, len(diffs)))
+				printFieldDiffs(diffs)
+			default:
+				pterm.Success.Println(fmt.Sprintf("%s: %d label(s) updated", appName, len(diffs)))
+				printFieldDiffs(diffs)
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to update labels for %d application(s)", failed)
+		}
+		return nil
+	},
+}
+
+var whitelistIPProxyCmd = &cobra.Command{
+	Use:   "whitelist-ip [cidr...]",
+	Short: "Restrict Traefik dashboard access to IP ranges",
+	Long: `Allow only the given CIDRs (or single IP addresses) to reach the Traefik
+dashboard and API on port 8080. Ranges are added to the saved allowlist and
+the Traefik container is recreated to apply it. Use --clear to open the
+dashboard again.
+
+Include 127.0.0.1 to keep 'finks proxy health' working from this host.
+
+Examples:
+  finks proxy whitelist-ip 10.0.0.0/8 127.0.0.1
+  finks proxy whitelist-ip --clear`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applyTo, _ := cmd.Flags().GetString("apply-to")
+		clearAllowlist, _ := cmd.Flags().GetBool("clear")
+
+		if applyTo != "dashboard" {
+			return fmt.Errorf("unsupported --apply-to %q: only dashboard is supported", applyTo)
+		}
+		if clearAllowlist == (len(args) > 0) {
+			return fmt.Errorf("specify either one or more CIDRs or --clear")
 		}
 
-		if status.IsRunning {
-			pterm.Info.Println(fmt.Sprintf("Traefik dashboard: %s", status.DashboardURL))
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		if clearAllowlist {
+			spinner, _ := pterm.DefaultSpinner.Start("Removing dashboard allowlist...")
+			if err := proxy.ClearDashboardAllowlist(ctx, proxyDockerClient); err != nil {
+				spinner.Fail(fmt.Sprintf("Failed to remove dashboard allowlist: %v", err))
+				return fmt.Errorf("failed to remove dashboard allowlist: %w", err)
+			}
+			spinner.Success("Traefik dashboard is reachable from any address")
+			return nil
 		}
 
+		spinner, _ := pterm.DefaultSpinner.Start("Restricting Traefik dashboard access...")
+		allowed, err := proxy.AllowDashboardIPs(ctx, proxyDockerClient, args)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to restrict dashboard access: %v", err))
+			return fmt.Errorf("failed to restrict dashboard access: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Traefik dashboard restricted to %s", strings.Join(allowed, ", ")))
 		return nil
 	},
 }
@@ -105,10 +431,118 @@ var connectProxyCmd = &cobra.Command{
 		}
 
 		spinner.Success(fmt.Sprintf("Traefik connected to network '%s' successfully!", networkName))
+
+		ip, err := proxyDockerClient.GetContainerIP(ctx, "finks-traefik", networkName)
+		if errors.Is(err, docker.ErrContainerNotOnNetwork) {
+			pterm.Warning.Println(fmt.Sprintf("Traefik does not appear on network '%s' yet; check 'finks network gateway'", networkName))
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get Traefik address on network %s: %w", networkName, err)
+		}
+		pterm.Info.Println(fmt.Sprintf("Traefik address on '%s': %s", networkName, valueOrDefault(ip, "-")))
+		return nil
+	},
+}
+
+var connectAllProxyCmd = &cobra.Command{
+	Use:   "connect-all",
+	Short: "Connect Traefik to all finks networks",
+	Long:  `Connect the Traefik proxy container to every finks application network it is not already attached to.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start("Connecting Traefik to all finks networks...")
+
+		connected, err := proxy.ConnectTraefikToAllAppNetworks(ctx, proxyDockerClient)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to connect Traefik to networks: %v", err))
+			return fmt.Errorf("failed to connect Traefik to networks: %w", err)
+		}
+
+		if len(connected) == 0 {
+			spinner.Success("Traefik is already connected to all finks networks")
+			return nil
+		}
+
+		spinner.Success(fmt.Sprintf("Traefik connected to %d network(s)", len(connected)))
+		for _, name := range connected {
+			pterm.Info.Println(name)
+		}
+		return nil
+	},
+}
+
+var connectAllAppsProxyCmd = &cobra.Command{
+	Use:   "connect-all-apps",
+	Short: "Connect every routed application to the Traefik network",
+	Long: `Connect each deployed application that has Traefik labels to the network
+Traefik reaches it on, then make sure Traefik is attached to those networks too.
+Applications without Traefik labels are listed; redeploy them with --domain to
+route them. An application that cannot be connected does not stop the others; the
+failures are listed at the end. Running the command again is safe.
+
+Examples:
+  finks proxy connect-all-apps`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start("Connecting applications to the Traefik network...")
+
+		result, err := manager.ConnectAppsToProxy(ctx)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to connect applications: %v", err))
+			return fmt.Errorf("failed to connect applications: %w", err)
+		}
+		if _, err := proxy.ConnectTraefikToAllAppNetworks(ctx, proxyDockerClient); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to connect Traefik to networks: %v", err))
+			return fmt.Errorf("failed to connect Traefik to networks: %w", err)
+		}
+
+		summary := fmt.Sprintf("%d app(s) already connected, %d app(s) connected now, %d app(s) missing Traefik labels",
+			len(result.AlreadyConnected), len(result.Connected), len(result.MissingLabels))
+		if len(result.Failed) > 0 {
+			spinner.Warning(fmt.Sprintf("%s, %d app(s) failed", summary, len(result.Failed)))
+		} else {
+			spinner.Success(summary)
+		}
+		if len(result.Connected) > 0 {
+			pterm.Info.Println(fmt.Sprintf("Connected: %s", strings.Join(result.Connected, ", ")))
+		}
+		if len(result.MissingLabels) > 0 {
+			pterm.Warning.Println(fmt.Sprintf("Missing Traefik labels: %s", strings.Join(result.MissingLabels, ", ")))
+		}
+		for _, name := range slices.Sorted(maps.Keys(result.Failed)) {
+			pterm.Error.Println(fmt.Sprintf("Failed to connect '%s': %v", name, result.Failed[name]))
+		}
+		if len(result.Failed) > 0 {
+			return fmt.Errorf("failed to connect %d application(s)", len(result.Failed))
+		}
 		return nil
 	},
 }
 
 func init() {
-	proxyCmd.AddCommand(installProxyCmd, statusProxyCmd, connectProxyCmd)
-}
\ No newline at end of file
+	proxyCmd.AddCommand(installProxyCmd, statusProxyCmd, proxyHealthCmd, connectProxyCmd, connectAllProxyCmd, connectAllAppsProxyCmd, updateLabelsProxyCmd, tlsStatusProxyCmd, accessLogProxyCmd, whitelistIPProxyCmd, pluginProxyCmd, backupProxyCmd, restoreProxyCmd, removeProxyCmd, entrypointProxyCmd, metricsProxyCmd, exportConfigProxyCmd, generateConfigProxyCmd, dashboardProxyCmd, middlewareProxyCmd)
+
+	updateLabelsProxyCmd.Flags().Bool("all", false, "Update labels for every application")
+	updateLabelsProxyCmd.Flags().Bool("dry-run", false, "Show label differences without recreating containers")
+	installProxyCmd.Flags().String("config-file", "", "Path to a traefik.toml or traefik.yaml static config file to mount")
+	installProxyCmd.Flags().Bool("local", false, "Serve plain HTTP only and do not publish port 443")
+	installProxyCmd.Flags().Bool("no-dashboard", false, "Disable the Traefik API and dashboard and do not publish port 8080")
+	installProxyCmd.Flags().String("traefik-version", proxy.DefaultTraefikVersion, "Traefik image tag to run (e.g. v3.1 or latest)")
+	proxyHealthCmd.Flags().String("url", proxy.DefaultAPIURL, "Traefik API address")
+	tlsStatusProxyCmd.Flags().String("url", proxy.DefaultAPIURL, "Traefik API address")
+	whitelistIPProxyCmd.Flags().String("apply-to", "dashboard", "What to restrict (dashboard)")
+	whitelistIPProxyCmd.Flags().Bool("clear", false, "Remove the allowlist")
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	accessLogFormat string
+	accessLogLines  int
+)
+
+var accessLogProxyCmd = &cobra.Command{
+	Use:   "access-log",
+	Short: "Manage the Traefik access log",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var enableAccessLogCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Enable the Traefik access log",
+	Long: `Enable the Traefik access log. The log is written to ~/.finks/traefik-logs on
+the host. The Traefik container is recreated, since Traefik reads its static
+configuration only at startup. The json format is recommended in production.
+
+Examples:
+  finks proxy access-log enable
+  finks proxy access-log enable --format json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start("Enabling Traefik access log...")
+
+		if err := proxy.EnableAccessLog(ctx, proxyDockerClient, accessLogFormat); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to enable access log: %v", err))
+			return fmt.Errorf("failed to enable access log: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Traefik access log enabled (%s format)", accessLogFormat))
+		if accessLogFormat != proxy.AccessLogFormatJSON {
+			pterm.Info.Println("Use --format json in production for easier log processing")
+		}
+		return nil
+	},
+}
+
+var disableAccessLogCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Disable the Traefik access log",
+	Long:  `Disable the Traefik access log. The Traefik container is recreated.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start("Disabling Traefik access log...")
+
+		if err := proxy.DisableAccessLog(ctx, proxyDockerClient); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to disable access log: %v", err))
+			return fmt.Errorf("failed to disable access log: %w", err)
+		}
+
+		spinner.Success("Traefik access log disabled")
+		return nil
+	},
+}
+
+var tailAccessLogCmd = &cobra.Command{
+	Use:   "tail",
+	Short: "Stream the Traefik access log",
+	Long: `Stream the Traefik access log from the container until interrupted.
+
+Examples:
+  finks proxy access-log tail
+  finks proxy access-log tail -n 200`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := proxy.TailAccessLog(ctx, proxyDockerClient, accessLogLines, os.Stdout); err != nil {
+			return fmt.Errorf("failed to tail access log: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	accessLogProxyCmd.AddCommand(enableAccessLogCmd, disableAccessLogCmd, tailAccessLogCmd)
+
+	enableAccessLogCmd.Flags().StringVar(&accessLogFormat, "format", proxy.AccessLogFormatCommon, "Access log format (common, json)")
+	tailAccessLogCmd.Flags().IntVarP(&accessLogLines, "lines", "n", 50, "Number of existing lines to show first")
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	acmeBackupOutput string
+	acmeRestoreInput string
+	skipBackupCheck  bool
+)
+
+var backupProxyCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up the Let's Encrypt certificates",
+	Long: `Copy acme.json, which holds the Let's Encrypt account and certificates, out of
+the Traefik container into a local file. Keep the file private, since it contains
+the certificates' private keys.
+
+Examples:
+  finks proxy backup --output acme.json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		data, err := proxy.BackupACME(ctx, proxyDockerClient)
+		if err != nil {
+			return fmt.Errorf("failed to back up certificates: %w", err)
+		}
+
+		if err := os.WriteFile(acmeBackupOutput, data, 0600); err != nil {
+			return fmt.Errorf("failed to write backup: %w", err)
+		}
+
+		pterm.Success.Printf("Certificates backed up to %s\n", acmeBackupOutput)
+		return nil
+	},
+}
+
+var restoreProxyCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the Let's Encrypt certificates from a backup",
+	Long: `Copy a backed up acme.json into the Traefik container and restart Traefik so it
+serves the restored certificates instead of requesting new ones.
+
+Examples:
+  finks proxy restore --input acme.json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := os.ReadFile(acmeRestoreInput)
+		if err != nil {
+			return fmt.Errorf("failed to read backup: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start("Restoring certificates...")
+
+		if err := proxy.RestoreACME(ctx, proxyDockerClient, data); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to restore certificates: %v", err))
+			return fmt.Errorf("failed to restore certificates: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Certificates restored from %s", acmeRestoreInput))
+		return nil
+	},
+}
+
+var removeProxyCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove the Traefik proxy container",
+	Long: `Remove the Traefik proxy container. Routed apps are unreachable until Traefik
+is installed again.
+
+Traefik keeps its Let's Encrypt certificates in /letsencrypt, which finks
+mounts from the host, so they are kept and reused by the next install. If that
+directory is not mounted (e.g. the container was created by hand) and holds
+certificates, the removal is refused until they are backed up with
+'finks proxy backup', or --skip-backup-check is passed.
+
+Examples:
+  finks proxy backup --output acme.json && finks proxy remove
+  finks proxy remove --skip-backup-check`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if !skipBackupCheck {
+			mount, err := proxy.ACMEStorageMount(ctx, proxyDockerClient)
+			if err != nil {
+				return fmt.Errorf("failed to check for certificates: %w", err)
+			}
+			if mount != "" {
+				pterm.Info.Println(fmt.Sprintf("Let's Encrypt certificates are kept in %s", mount))
+			} else {
+				hasCerts, err := proxy.HasACMECertificates(ctx, proxyDockerClient)
+				if err != nil {
+					return fmt.Errorf("failed to check for certificates: %w", err)
+				}
+				if hasCerts {
+					pterm.Warning.Println("Traefik holds Let's Encrypt certificates outside a mounted volume, so they are lost with the container")
+					return fmt.Errorf("back up the certificates with 'finks proxy backup --output <file>' first, or pass --skip-backup-check")
+				}
+			}
+		}
+
+		spinner, _ := pterm.DefaultSpinner.Start("Removing Traefik proxy...")
+
+		if err := proxy.RemoveTraefik(ctx, proxyDockerClient); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to remove Traefik: %v", err))
+			return fmt.Errorf("failed to remove Traefik: %w", err)
+		}
+
+		spinner.Success("Traefik proxy removed")
+		return nil
+	},
+}
+
+func init() {
+	backupProxyCmd.Flags().StringVarP(&acmeBackupOutput, "output", "o", "", "File to write the backup to (required)")
+	backupProxyCmd.MarkFlagRequired("output")
+	restoreProxyCmd.Flags().StringVarP(&acmeRestoreInput, "input", "i", "", "Backup file to restore (required)")
+	restoreProxyCmd.MarkFlagRequired("input")
+	removeProxyCmd.Flags().BoolVar(&skipBackupCheck, "skip-backup-check", false, "Remove Traefik without checking for certificates to back up")
+}
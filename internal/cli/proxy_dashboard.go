@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	dashboardBrowser string
+	dashboardURLOnly bool
+)
+
+var dashboardProxyCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "Traefik dashboard commands",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var openDashboardProxyCmd = &cobra.Command{
+	Use:   "open",
+	Short: "Open the Traefik dashboard in a browser",
+	Long: `Open the Traefik dashboard in the default browser, using open on macOS,
+xdg-open on Linux and start on Windows. Use --browser to pick another browser,
+or --url-only to print the address instead.
+
+Examples:
+  finks proxy dashboard open
+  finks proxy dashboard open --browser firefox
+  finks proxy dashboard open --url-only`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if dashboardURLOnly {
+			fmt.Println(proxy.DashboardURL)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		status, err := proxy.GetTraefikStatus(ctx, proxyDockerClient)
+		if err != nil {
+			return fmt.Errorf("failed to get Traefik status: %w", err)
+		}
+		if !status.IsRunning {
+			return fmt.Errorf("traefik is not running; start it with 'finks proxy install'")
+		}
+		if !status.DashboardEnabled {
+			return fmt.Errorf("the Traefik dashboard is disabled; enable it with 'finks proxy install --no-dashboard=false'")
+		}
+
+		if err := openBrowser(dashboardBrowser, proxy.DashboardURL); err != nil {
+			return fmt.Errorf("failed to open the dashboard: %w (it is available at %s)", err, proxy.DashboardURL)
+		}
+		pterm.Success.Println("Opened the Traefik dashboard at " + proxy.DashboardURL)
+		return nil
+	},
+}
+
+// openBrowser opens url with browser, or with the platform's default handler when
+// browser is empty. It does not wait for the browser to exit.
+func openBrowser(browser, url string) error {
+	var cmd *exec.Cmd
+	switch {
+	case browser != "":
+		cmd = exec.Command(browser, url)
+	case runtime.GOOS == "darwin":
+		cmd = exec.Command("open", url)
+	case runtime.GOOS == "windows":
+		// start is built into cmd; its first quoted argument is the window title
+		cmd = exec.Command("cmd", "/c", "start", "", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	return cmd.Process.Release()
+}
+
+func init() {
+	dashboardProxyCmd.AddCommand(openDashboardProxyCmd)
+
+	openDashboardProxyCmd.Flags().StringVar(&dashboardBrowser, "browser", "", "Path or name of the browser to open the dashboard with")
+	openDashboardProxyCmd.Flags().BoolVar(&dashboardURLOnly, "url-only", false, "Print the dashboard URL instead of opening it")
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var entrypointAddress string
+
+var entrypointProxyCmd = &cobra.Command{
+	Use:   "entrypoint",
+	Short: "Manage custom Traefik entrypoints",
+	Long: `Manage custom Traefik entrypoints, e.g. for gRPC, SMTP or other TCP and UDP
+services. Traefik reads entrypoints only at startup, so the Traefik container is
+recreated whenever they change.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var addEntrypointCmd = &cobra.Command{
+	Use:   "add <name> --address <[host]:port[/tcp|/udp]>",
+	Short: "Add a custom Traefik entrypoint",
+	Long: `Add a custom entrypoint and recreate the Traefik container so it listens on it.
+The entrypoint's port is published on the host. Adding an existing entrypoint
+changes its address.
+
+Examples:
+  finks proxy entrypoint add grpc --address :50051
+  finks proxy entrypoint add dns --address :53/udp`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Adding Traefik entrypoint '%s'...", name))
+
+		if err := proxy.AddEntrypoint(ctx, proxyDockerClient, name, entrypointAddress); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to add entrypoint: %v", err))
+			return fmt.Errorf("failed to add entrypoint: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Entrypoint '%s' listening on %s", name, entrypointAddress))
+		return nil
+	},
+}
+
+var removeEntrypointCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove a custom Traefik entrypoint",
+	Long: `Remove a custom entrypoint and recreate the Traefik container without it.
+The removal is refused while routers use the entrypoint, unless --force is passed.
+
+Examples:
+  finks proxy entrypoint remove grpc`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		apiURL, _ := cmd.Flags().GetString("url")
+		force, _ := cmd.Flags().GetBool("force")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Removing Traefik entrypoint '%s'...", name))
+
+		if err := proxy.RemoveEntrypoint(ctx, proxyDockerClient, name, apiURL, force); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to remove entrypoint: %v", err))
+			return fmt.Errorf("failed to remove entrypoint: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Entrypoint '%s' removed", name))
+		return nil
+	},
+}
+
+var listEntrypointsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List custom Traefik entrypoints",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings, err := proxy.LoadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load proxy settings: %w", err)
+		}
+
+		if len(settings.Entrypoints) == 0 {
+			pterm.Info.Println("No custom Traefik entrypoints configured")
+			return nil
+		}
+
+		tableData := pterm.TableData{{"NAME", "ADDRESS"}}
+		for _, name := range slices.Sorted(maps.Keys(settings.Entrypoints)) {
+			tableData = append(tableData, []string{name, settings.Entrypoints[name]})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+func init() {
+	entrypointProxyCmd.AddCommand(addEntrypointCmd, removeEntrypointCmd, listEntrypointsCmd)
+
+	addEntrypointCmd.Flags().StringVar(&entrypointAddress, "address", "", "Address to listen on, e.g. :50051 or :53/udp")
+	addEntrypointCmd.MarkFlagRequired("address")
+	removeEntrypointCmd.Flags().String("url", proxy.DefaultAPIURL, "Traefik API address")
+	removeEntrypointCmd.Flags().Bool("force", false, "Remove the entrypoint even if routers use it")
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportConfigOutput string
+	exportConfigFormat string
+)
+
+var exportConfigProxyCmd = &cobra.Command{
+	Use:   "export-config",
+	Short: "Print the dynamic configuration Traefik is routing with",
+	Long: `Fetch the effective dynamic configuration (routers, services, middlewares and
+TLS) from the Traefik API. This is what Traefik actually routes with, so it shows
+whether the routers expected from container labels were created.
+
+Examples:
+  finks proxy export-config
+  finks proxy export-config --format yaml
+  finks proxy export-config --output traefik-config.json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiURL, _ := cmd.Flags().GetString("url")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		data, err := proxy.ExportConfig(ctx, apiURL, exportConfigFormat)
+		if err != nil {
+			return fmt.Errorf("failed to export Traefik configuration: %w", err)
+		}
+
+		if exportConfigOutput == "" {
+			_, err := os.Stdout.Write(data)
+			return err
+		}
+
+		if err := os.WriteFile(exportConfigOutput, data, 0644); err != nil {
+			return fmt.Errorf("failed to write configuration: %w", err)
+		}
+		pterm.Success.Printf("Traefik configuration written to %s\n", exportConfigOutput)
+		return nil
+	},
+}
+
+func init() {
+	exportConfigProxyCmd.Flags().StringVarP(&exportConfigOutput, "output", "o", "", "Write the configuration to this file instead of stdout")
+	exportConfigProxyCmd.Flags().StringVar(&exportConfigFormat, "format", proxy.ExportFormatJSON, "Output format (json or yaml)")
+	exportConfigProxyCmd.Flags().String("url", proxy.DefaultAPIURL, "Traefik API address")
+}
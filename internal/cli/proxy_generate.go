@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	generateConfigLocal  bool
+	generateConfigEmail  string
+	generateConfigOutput string
+	generateConfigFormat string
+)
+
+var generateConfigProxyCmd = &cobra.Command{
+	Use:   "generate-config",
+	Short: "Generate a static Traefik configuration file",
+	Long: `Generate a complete static Traefik configuration with the entrypoints,
+providers, API and Let's Encrypt resolver finks would configure, including the
+access log, plugins, custom entrypoints and metrics set with other proxy commands.
+
+The file can be edited and mounted with 'finks proxy install --config-file', or
+used with docker run as shown in the comment at its top.
+
+Examples:
+  finks proxy generate-config --email admin@example.com --output traefik.toml
+  finks proxy generate-config --local --format yaml --output traefik.yaml`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings, err := proxy.LoadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load proxy settings: %w", err)
+		}
+		if cmd.Flags().Changed("local") {
+			settings.LocalMode = generateConfigLocal
+		}
+		if settings.LocalMode && generateConfigEmail != "" {
+			pterm.Warning.Println("--email is ignored in local mode, which has no Let's Encrypt resolver")
+		}
+
+		data, err := proxy.GenerateStaticConfig(settings, generateConfigEmail, generateConfigFormat)
+		if err != nil {
+			return fmt.Errorf("failed to generate Traefik configuration: %w", err)
+		}
+
+		if generateConfigOutput == "" {
+			_, err := os.Stdout.Write(data)
+			return err
+		}
+
+		if err := os.WriteFile(generateConfigOutput, data, 0644); err != nil {
+			return fmt.Errorf("failed to write configuration: %w", err)
+		}
+		pterm.Success.Printf("Traefik configuration written to %s\n", generateConfigOutput)
+		return nil
+	},
+}
+
+func init() {
+	generateConfigProxyCmd.Flags().BoolVar(&generateConfigLocal, "local", false, "Serve plain HTTP only, without the HTTPS entrypoint and Let's Encrypt resolver")
+	generateConfigProxyCmd.Flags().StringVar(&generateConfigEmail, "email", "", "Email address for the Let's Encrypt account")
+	generateConfigProxyCmd.Flags().StringVarP(&generateConfigOutput, "output", "o", "", "Write the configuration to this file instead of stdout")
+	generateConfigProxyCmd.Flags().StringVar(&generateConfigFormat, "format", proxy.StaticConfigFormatTOML, "Output format (toml or yaml)")
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// metricsSampleCount is how many metric names metrics status prints
+const metricsSampleCount = 10
+
+var metricsAddr string
+
+var metricsProxyCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Manage Traefik's Prometheus metrics endpoint",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var enableMetricsCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Serve Prometheus metrics from Traefik",
+	Long: `Serve Traefik's Prometheus metrics at /metrics on a dedicated entrypoint. The
+port is published on the host and the Traefik container is recreated.
+
+Examples:
+  finks proxy metrics enable
+  finks proxy metrics enable --addr 127.0.0.1:9100`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start("Enabling Traefik metrics...")
+
+		if err := proxy.EnableMetrics(ctx, proxyDockerClient, metricsAddr); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to enable metrics: %v", err))
+			return fmt.Errorf("failed to enable metrics: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Traefik metrics available at %s", proxy.MetricsURL(metricsAddr)))
+		return nil
+	},
+}
+
+var disableMetricsCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Stop serving Prometheus metrics from Traefik",
+	Long:  `Remove the metrics entrypoint. The Traefik container is recreated.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start("Disabling Traefik metrics...")
+
+		if err := proxy.DisableMetrics(ctx, proxyDockerClient); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to disable metrics: %v", err))
+			return fmt.Errorf("failed to disable metrics: %w", err)
+		}
+
+		spinner.Success("Traefik metrics disabled")
+		return nil
+	},
+}
+
+var statusMetricsCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Check that the Prometheus metrics endpoint responds",
+	Long: `Scrape the metrics endpoint, check that it returns the Prometheus text format
+and print some of the metric names it exposes.
+
+Examples:
+  finks proxy metrics status
+  finks proxy metrics status --url http://10.0.0.5:8082/metrics`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		url, _ := cmd.Flags().GetString("url")
+		if url == "" {
+			settings, err := proxy.LoadSettings()
+			if err != nil {
+				return fmt.Errorf("failed to load proxy settings: %w", err)
+			}
+			if settings.MetricsAddr == "" {
+				pterm.Info.Println("Traefik metrics are disabled, enable them with 'finks proxy metrics enable'")
+				return nil
+			}
+			url = proxy.MetricsURL(settings.MetricsAddr)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		names, err := proxy.ScrapeMetrics(ctx, url)
+		if err != nil {
+			pterm.Error.Println(fmt.Sprintf("Metrics endpoint unhealthy: %v", err))
+			return fmt.Errorf("metrics endpoint check failed: %w", err)
+		}
+
+		pterm.Success.Printf("Metrics endpoint at %s serves %d metric families\n", url, len(names))
+		sample := names[:min(metricsSampleCount, len(names))]
+		fmt.Println("  " + strings.Join(sample, "\n  "))
+		if len(names) > len(sample) {
+			fmt.Println(pterm.FgGray.Sprintf("  ... and %d more", len(names)-len(sample)))
+		}
+		return nil
+	},
+}
+
+func init() {
+	metricsProxyCmd.AddCommand(enableMetricsCmd, disableMetricsCmd, statusMetricsCmd)
+
+	enableMetricsCmd.Flags().StringVar(&metricsAddr, "addr", proxy.DefaultMetricsAddr, "Address the metrics entrypoint listens on")
+	statusMetricsCmd.Flags().String("url", "", "Metrics URL to check (default is derived from the configured address)")
+}
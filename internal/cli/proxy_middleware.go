@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	middlewareNames        []string
+	middlewareRenamePrefix string
+	middlewareDryRun       bool
+)
+
+var middlewareProxyCmd = &cobra.Command{
+	Use:   "middleware",
+	Short: "Manage the Traefik middlewares of applications",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var copyMiddlewareProxyCmd = &cobra.Command{
+	Use:   "copy <src-app> <dst-app>",
+	Short: "Copy middleware labels from one application to another",
+	Long: `Copy the traefik.http.middlewares.* labels of an application's container to
+another application, and recreate the destination container with them.
+
+Middleware names containing the source app name get the destination app name
+instead. For names that do not follow that pattern, --rename-prefix OLD=NEW
+replaces the prefix OLD with NEW. Middlewares used by the router of the source app
+are also added to the router of the destination app, if it has a domain.
+
+The copied labels are stored with the destination app, so they are kept when its
+labels are regenerated. Use --middleware to copy only some middlewares; a name
+matches a middleware called exactly that or containing it as a dash-separated part.
+
+Examples:
+  finks proxy middleware copy api admin
+  finks proxy middleware copy api admin --middleware ratelimit,auth
+  finks proxy middleware copy api admin --rename-prefix shared-=admin- --dry-run`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		src, dst := args[0], args[1]
+
+		opts := proxy.MiddlewareCopyOptions{Names: middlewareNames}
+		if middlewareRenamePrefix != "" {
+			oldPrefix, newPrefix, ok := strings.Cut(middlewareRenamePrefix, "=")
+			if !ok || oldPrefix == "" {
+				return fmt.Errorf("invalid --rename-prefix %q: expected OLD=NEW", middlewareRenamePrefix)
+			}
+			opts.OldPrefix, opts.NewPrefix = oldPrefix, newPrefix
+		}
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Copying middlewares from %s to %s...", src, dst))
+		diffs, err := manager.CopyMiddlewares(ctx, src, dst, opts, middlewareDryRun)
+		if err != nil {
+			spinner.Fail("Failed to copy middlewares")
+			return fmt.Errorf("failed to copy middlewares: %w", err)
+		}
+
+		switch {
+		case len(diffs) == 0:
+			spinner.Success(fmt.Sprintf("%s already has these middlewares", dst))
+		case middlewareDryRun:
+			spinner.Info(fmt.Sprintf("%d label(s) would change on %s", len(diffs), dst))
+			printFieldDiffs(diffs)
+		default:
+			spinner.Success(fmt.Sprintf("Copied middlewares to %s (%d label(s) changed)", dst, len(diffs)))
+			printFieldDiffs(diffs)
+		}
+		return nil
+	},
+}
+
+func init() {
+	middlewareProxyCmd.AddCommand(copyMiddlewareProxyCmd)
+
+	copyMiddlewareProxyCmd.Flags().StringSliceVar(&middlewareNames, "middleware", []string{}, "Only copy these middlewares (comma-separated)")
+	copyMiddlewareProxyCmd.Flags().StringVar(&middlewareRenamePrefix, "rename-prefix", "", "Rename middlewares starting with OLD to start with NEW, as OLD=NEW")
+	copyMiddlewareProxyCmd.Flags().BoolVar(&middlewareDryRun, "dry-run", false, "Show label differences without recreating the container")
+}
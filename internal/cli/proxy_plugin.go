@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pluginModule  string
+	pluginVersion string
+)
+
+var pluginProxyCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage Traefik plugins",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var addPluginCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a Traefik plugin",
+	Long: `Add a plugin from the Traefik plugin catalog and recreate the Traefik
+container to load it. Adding a plugin that is already configured updates it.
+The name is how middlewares refer to the plugin.
+
+Examples:
+  finks proxy plugin add demo --module github.com/traefik/plugindemo --version v0.2.2`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Adding Traefik plugin '%s'...", name))
+
+		plugin := proxy.PluginConfig{Name: name, Module: pluginModule, Version: pluginVersion}
+		if err := proxy.AddPlugin(ctx, proxyDockerClient, plugin); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to add plugin: %v", err))
+			return fmt.Errorf("failed to add plugin: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Plugin '%s' (%s@%s) added", name, pluginModule, pluginVersion))
+		return nil
+	},
+}
+
+var removePluginCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove a Traefik plugin",
+	Long:  `Remove a plugin and recreate the Traefik container without it.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Removing Traefik plugin '%s'...", name))
+
+		if err := proxy.RemovePlugin(ctx, proxyDockerClient, name); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to remove plugin: %v", err))
+			return fmt.Errorf("failed to remove plugin: %w", err)
+		}
+
+		spinner.Success(fmt.Sprintf("Plugin '%s' removed", name))
+		return nil
+	},
+}
+
+var listPluginsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configured Traefik plugins",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings, err := proxy.LoadSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load proxy settings: %w", err)
+		}
+
+		if len(settings.Plugins) == 0 {
+			pterm.Info.Println("No Traefik plugins configured")
+			return nil
+		}
+
+		tableData := pterm.TableData{{"NAME", "MODULE", "VERSION"}}
+		for _, plugin := range settings.Plugins {
+			tableData = append(tableData, []string{plugin.Name, plugin.Module, plugin.Version})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+func init() {
+	pluginProxyCmd.AddCommand(addPluginCmd, removePluginCmd, listPluginsCmd)
+
+	addPluginCmd.Flags().StringVar(&pluginModule, "module", "", "Go module of the plugin, e.g. github.com/traefik/plugindemo")
+	addPluginCmd.Flags().StringVar(&pluginVersion, "version", "", "Plugin version, e.g. v0.2.2")
+	addPluginCmd.MarkFlagRequired("module")
+	addPluginCmd.MarkFlagRequired("version")
+}
@@ -17,10 +17,16 @@ It provides commands to monitor, deploy, and manage applications with ease.`,
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() error {
+	cobra.OnInitialize(initLogging)
 	return rootCmd.Execute()
 }
 
 func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Path to config file (default $FINKS_CONFIG or ~/.finks/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "", "Log level (debug, info, warn, error); logging is off unless set")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", "Log format (text, json)")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", "text", "Output format (text, json; app list also accepts table-wide)")
+
 	// Add subcommands
-	rootCmd.AddCommand(appCmd, serverCmd, networkCmd, proxyCmd)
+	rootCmd.AddCommand(appCmd, serverCmd, networkCmd, proxyCmd, versionCmd, completionCmd, configCmd, schedulerCmd, imageCmd, installCmd, initCmd, upCmd, downCmd, psCmd)
 }
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/bimalpaudels/finks/internal/deployment"
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/bimalpaudels/finks/internal/scheduler"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var schedulerCmd = &cobra.Command{
+	Use:   "scheduler",
+	Short: "Run and inspect scheduled tasks",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var startSchedulerCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Run the task scheduler in the foreground",
+	Long: `Run scheduled tasks (added with 'finks app schedule') until interrupted.
+Run it under a service manager such as systemd to keep it running in the background.
+Each execution is recorded in ~/.finks/schedule-log.jsonl.
+
+The scheduler also restarts applications killed for running out of memory when
+they have 'finks app autorestart --on-oom' set.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := docker.NewClient()
+		if err != nil {
+			return fmt.Errorf("failed to initialize Docker client: %w", err)
+		}
+		defer client.Close()
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := client.IsAvailable(ctx); err != nil {
+			return err
+		}
+
+		manager, err := deployment.NewManager()
+		if err != nil {
+			return fmt.Errorf("failed to initialize app manager: %w", err)
+		}
+		defer manager.Close()
+		go manager.WatchOOM(ctx)
+
+		pterm.Info.Println("Scheduler started. Press Ctrl+C to stop.")
+		return scheduler.NewScheduler(client).Run(ctx)
+	},
+}
+
+var listSchedulerCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scheduled tasks",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks, err := scheduler.LoadTasks()
+		if err != nil {
+			return fmt.Errorf("failed to load scheduled tasks: %w", err)
+		}
+
+		if len(tasks) == 0 {
+			pterm.Info.Println("No scheduled tasks.")
+			return nil
+		}
+
+		tableData := pterm.TableData{{"ID", "APP", "CRON", "COMMAND"}}
+		for _, task := range tasks {
+			tableData = append(tableData, []string{task.ID, task.App, task.Cron, task.Command})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+var removeSchedulerCmd = &cobra.Command{
+	Use:   "remove <task-id>",
+	Short: "Remove a scheduled task",
+	Long: `Remove a scheduled task by the ID shown by 'finks scheduler list'. A running
+scheduler picks up the change at its next minute.
+
+Tasks are also removed with their application by 'finks app remove', and follow
+it through 'finks app rename'.
+
+Examples:
+  finks scheduler remove web-1760000000000000000`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		task, err := scheduler.RemoveTask(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to remove scheduled task: %w", err)
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Removed task %s (%s on %s)", task.ID, task.Command, task.App))
+		return nil
+	},
+}
+
+func init() {
+	schedulerCmd.AddCommand(startSchedulerCmd, listSchedulerCmd, removeSchedulerCmd)
+}
@@ -1,9 +1,57 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/monitor"
 	"github.com/spf13/cobra"
 )
 
+// Display formats of server monitor
+const (
+	monitorFormatFull    = "full"
+	monitorFormatCompact = "compact"
+)
+
+var (
+	monitorInterval        time.Duration
+	monitorCPUThreshold    float64
+	monitorMemoryThreshold float64
+	monitorDiskThreshold   float64
+	monitorWebhook         string
+	monitorWebhookSecret   string
+	monitorWatchProcesses  []string
+	monitorDiskPaths       []string
+	monitorAlertHistory    bool
+	monitorHistoryWindow   time.Duration
+	monitorExportCSV       string
+	monitorShowUser        bool
+	monitorFilterUser      string
+	monitorProcessFilter   []string
+	monitorProcessExclude  []string
+	monitorSnapshotDir     string
+	monitorRetention       string
+	monitorDiskAlertPaths  []string
+	monitorRecord          bool
+	monitorRecordRetention string
+	monitorExportSVG       bool
+	monitorExportEvery     time.Duration
+	monitorFormat          string
+	monitorCompactSep      string
+	monitorPerCPU          bool
+	monitorReceivePort     int
+	monitorHighlight       monitor.ColorThresholds
+)
+
 // serverCmd represents the server command
 var serverCmd = &cobra.Command{
 	Use:   "server",
@@ -14,3 +62,377 @@ var serverCmd = &cobra.Command{
 		cmd.Help()
 	},
 }
+
+var monitorCmd = &cobra.Command{
+	Use:   "monitor",
+	Short: "Monitor server resources in real time",
+	Long: `Display live CPU, memory, disk, network and process metrics.
+
+On Linux hosts with CPU frequency scaling, the System Overview also shows the
+scaling governor and current frequency of the first core. Change the governor
+with 'finks server cpu-governor set'.
+
+Alerts fire when usage crosses a threshold. If a webhook is configured (with
+--webhook or 'finks server alerts webhook'), each alert is posted to it as JSON.
+--disk-threshold applies to the root partition; use --disk-alert-path to give
+other partitions their own threshold, which raises a critical alert when crossed.
+Every alert is also recorded in ~/.finks/alerts.jsonl; review it with --alert-history.
+
+With --save-screenshot, each refresh is also saved as plain text to
+metrics_<timestamp>.txt in the given directory, and snapshots older than
+--retention are deleted. Use a longer --interval to control how often they are taken.
+
+With --record, each refresh is appended to ~/.finks/metrics.jsonl, and samples
+older than --record-retention are dropped once an hour. Adding
+--export-svg draws the recorded CPU, memory, disk and network usage of the last
+--export-every period as an SVG chart in ~/.finks/charts every --export-every.
+
+With --format compact, a single line with CPU, memory, root disk, load and network
+usage is rewritten in place instead of taking over the terminal. When stdout is not
+a terminal, each sample is printed on its own line. Colors are left out when
+NO_COLOR is set or stdout is not a terminal.
+
+--process-filter and --process-exclude take comma-separated glob patterns and
+limit the Top CPU and Top Memory lists to processes whose name matches. Excludes
+win over includes, and * does not match /, so kernel threads such as kworker/0:1
+are matched with 'kworker/*'. Processes matching --watch-process are still
+shown in the WATCHED panel.
+
+Usage turns yellow at --highlight-low, orange at --highlight-medium and red at
+--highlight-high percent (50, 70 and 90 by default). Lower them to see pressure
+earlier, e.g. on a small VPS.
+
+With --alert-receive-port, the monitor also accepts alerts that other finks
+instances post to http://<host>:<port>/alert with their --webhook, and lists the
+latest ones in a Received Alerts section of the full display. It requires a
+webhook secret (--webhook-secret or the saved one): requests must carry a
+matching X-Finks-Signature, so the senders must use the same secret.
+
+Examples:
+  finks server monitor
+  finks server monitor --format compact --compact-separator ' · '
+  finks server monitor --interval 5s --cpu-threshold 80
+  finks server monitor --per-cpu
+  finks server monitor --highlight-medium 60 --highlight-high 80
+  finks server monitor --watch-process 'nginx*' --watch-process postgres
+  finks server monitor --disk-path / --disk-path /var/lib/postgresql
+  finks server monitor --disk-alert-path /data:90 --disk-alert-path /backup:95
+  finks server monitor --show-user --filter-user www-data
+  finks server monitor --process-filter 'nginx*,php*'
+  finks server monitor --process-exclude 'kworker/*,ksoftirqd/*'
+  finks server monitor --save-screenshot /var/lib/finks/snapshots --interval 5m --retention 7d
+  finks server monitor --record --export-svg --export-every 1h
+  finks server monitor --webhook https://example.com/hooks/finks --webhook-secret s3cret
+  finks server monitor --alert-history --last 72h --export-csv alerts.csv
+
+  # Central monitor, and a server sending its alerts to it
+  finks server monitor --alert-receive-port 9092 --webhook-secret s3cret
+  finks server monitor --webhook http://monitor.example.com:9092/alert --webhook-secret s3cret`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if monitorAlertHistory {
+			return showAlertHistory(monitorHistoryWindow, monitorExportCSV)
+		}
+		if monitorExportCSV != "" {
+			return fmt.Errorf("--export-csv requires --alert-history")
+		}
+
+		if monitorInterval < time.Second {
+			return fmt.Errorf("--interval must be at least 1s")
+		}
+		if monitorFormat != monitorFormatFull && monitorFormat != monitorFormatCompact {
+			return fmt.Errorf("invalid --format %q: must be %s or %s", monitorFormat, monitorFormatFull, monitorFormatCompact)
+		}
+		if monitorReceivePort != 0 && monitorFormat == monitorFormatCompact {
+			return fmt.Errorf("--alert-receive-port cannot be used with --format compact, which has no room for received alerts")
+		}
+		if err := monitorHighlight.Validate(); err != nil {
+			return fmt.Errorf("invalid --highlight-* flags: %w", err)
+		}
+
+		alerters, err := configuredAlerters(monitorWebhook, monitorWebhookSecret)
+		if err != nil {
+			return err
+		}
+		alerters = append(alerters, monitor.NewHistoryAlerter())
+
+		diskAlerts, err := parseDiskAlertPaths(monitorDiskAlertPaths)
+		if err != nil {
+			return err
+		}
+
+		var retention time.Duration
+		if monitorSnapshotDir != "" {
+			retention, err = parseDayDuration("retention", monitorRetention)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(monitorSnapshotDir, 0755); err != nil {
+				return fmt.Errorf("failed to create snapshot directory: %w", err)
+			}
+		}
+
+		var recorder *monitor.MetricsRecorder
+		var chartsDir string
+		var recordRetention time.Duration
+		if monitorRecord {
+			recordRetention, err = parseDayDuration("record-retention", monitorRecordRetention)
+			if err != nil {
+				return err
+			}
+			recorder = monitor.NewMetricsRecorder(recordRetention)
+		}
+		if monitorExportSVG {
+			if !monitorRecord {
+				return fmt.Errorf("--export-svg requires --record")
+			}
+			if monitorExportEvery < time.Minute {
+				return fmt.Errorf("--export-every must be at least 1m")
+			}
+			if recordRetention > 0 && recordRetention < monitorExportEvery {
+				return fmt.Errorf("--record-retention must be at least --export-every")
+			}
+			chartsDir, err = monitor.ChartsDir()
+			if err != nil {
+				return err
+			}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		var receiver *monitor.AlertReceiver
+		if monitorReceivePort != 0 {
+			secret, err := receiverSecret(monitorWebhookSecret)
+			if err != nil {
+				return err
+			}
+			if secret == "" {
+				return fmt.Errorf("--alert-receive-port requires a webhook secret to verify alerts: set --webhook-secret or save one with 'finks server alerts webhook --secret'")
+			}
+			receiver = monitor.NewAlertReceiver(secret)
+			if err := receiver.Start(ctx, monitorReceivePort); err != nil {
+				return err
+			}
+		}
+
+		if err := validateProcessPatterns("watch-process", monitorWatchProcesses); err != nil {
+			return err
+		}
+		if err := validateProcessPatterns("process-filter", monitorProcessFilter); err != nil {
+			return err
+		}
+		if err := validateProcessPatterns("process-exclude", monitorProcessExclude); err != nil {
+			return err
+		}
+
+		service := monitor.NewMetricsService(monitor.MetricsOptions{
+			WatchPatterns: monitorWatchProcesses,
+			DiskPaths:     monitorDiskPaths,
+			Users:         monitorShowUser,
+			FilterUser:    monitorFilterUser,
+			PerCPU:        monitorPerCPU,
+			ProcessFilter: monitor.ProcessFilter{
+				IncludePatterns: monitorProcessFilter,
+				ExcludePatterns: monitorProcessExclude,
+			},
+		})
+		tracker := monitor.NewAlertTracker()
+		alertConfig := monitor.AlertConfig{
+			CPUThreshold:    monitorCPUThreshold,
+			MemoryThreshold: monitorMemoryThreshold,
+			DiskThreshold:   monitorDiskThreshold,
+			DiskAlerts:      diskAlerts,
+		}
+
+		lastExport := time.Now()
+		onMetrics := func(metrics *monitor.SystemMetrics) {
+			for _, alert := range tracker.Fired(monitor.CheckAlerts(metrics, alertConfig)) {
+				dispatchAlert(alert, alerters)
+			}
+			recordBreaches(tracker.Ended())
+			if monitorSnapshotDir != "" {
+				if err := monitor.SaveSnapshot(monitorSnapshotDir, metrics, retention); err != nil {
+					slog.Error("failed to save metrics snapshot", "dir", monitorSnapshotDir, "error", err)
+				}
+			}
+			if recorder != nil {
+				if err := recorder.Record(metrics); err != nil {
+					slog.Error("failed to record metrics", "error", err)
+				}
+			}
+			if chartsDir != "" && time.Since(lastExport) >= monitorExportEvery {
+				lastExport = time.Now()
+				if err := exportMetricsChart(chartsDir, monitorExportEvery); err != nil {
+					slog.Error("failed to export metrics chart", "dir", chartsDir, "error", err)
+				}
+			}
+		}
+
+		if monitorFormat == monitorFormatCompact {
+			interactive := isTerminal(os.Stdout)
+			err = monitor.RunCompact(ctx, service, monitorInterval, onMetrics, os.Stdout, monitor.CompactOptions{
+				Separator:  monitorCompactSep,
+				Color:      interactive && os.Getenv("NO_COLOR") == "",
+				Overwrite:  interactive,
+				Thresholds: monitorHighlight,
+			})
+		} else {
+			err = monitor.Run(ctx, monitor.NewModel(ctx, service, monitorInterval, onMetrics).WithReceiver(receiver).WithColorThresholds(monitorHighlight))
+		}
+
+		// Breaches still ongoing end with the monitor so their duration is not lost
+		recordBreaches(tracker.Finish())
+		return err
+	},
+}
+
+// recordBreaches saves ended breaches for server alert-summary
+func recordBreaches(breaches []monitor.Breach) {
+	for _, breach := range breaches {
+		if err := monitor.RecordBreach(breach); err != nil {
+			slog.Error("failed to record breach", "resource", breach.Subject(), "error", err)
+		}
+	}
+}
+
+// configuredAlerters builds the alert channels from flags, falling back to saved settings
+func configuredAlerters(webhookURL, webhookSecret string) ([]monitor.Alerter, error) {
+	if webhookURL == "" {
+		settings, err := monitor.LoadAlertSettings()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load alert settings: %w", err)
+		}
+		webhookURL = settings.WebhookURL
+		if webhookSecret == "" {
+			webhookSecret = settings.WebhookSecret
+		}
+	}
+
+	var alerters []monitor.Alerter
+	if webhookURL != "" {
+		alerters = append(alerters, monitor.NewWebhookAlerter(webhookURL, webhookSecret))
+	}
+	return alerters, nil
+}
+
+// receiverSecret returns the secret received alerts must be signed with, falling back to saved settings
+func receiverSecret(secret string) (string, error) {
+	if secret != "" {
+		return secret, nil
+	}
+	settings, err := monitor.LoadAlertSettings()
+	if err != nil {
+		return "", fmt.Errorf("failed to load alert settings: %w", err)
+	}
+	return settings.WebhookSecret, nil
+}
+
+// parseDiskAlertPaths parses <mountpoint>:<percent> values into per-partition thresholds
+func parseDiskAlertPaths(values []string) (map[string]float64, error) {
+	thresholds := make(map[string]float64, len(values))
+	for _, value := range values {
+		idx := strings.LastIndex(value, ":")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid --disk-alert-path %q: expected <mountpoint>:<percent>, e.g. /data:90", value)
+		}
+		threshold, err := strconv.ParseFloat(value[idx+1:], 64)
+		if err != nil || threshold <= 0 || threshold > 100 {
+			return nil, fmt.Errorf("invalid --disk-alert-path %q: threshold must be a percentage between 0 and 100", value)
+		}
+		thresholds[filepath.Clean(value[:idx])] = threshold
+	}
+	return thresholds, nil
+}
+
+// isTerminal reports whether f is a terminal rather than a pipe or file
+// validateProcessPatterns checks that the glob patterns of a flag are well formed
+func validateProcessPatterns(flag string, patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid --%s pattern %q: %w", flag, pattern, err)
+		}
+	}
+	return nil
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// exportMetricsChart draws the metrics recorded during the last window as an SVG chart in dir
+func exportMetricsChart(dir string, window time.Duration) error {
+	samples, err := monitor.LoadMetricsHistory(time.Now().Add(-window))
+	if err != nil {
+		return err
+	}
+	path, err := monitor.ExportChart(dir, samples)
+	if err != nil {
+		return err
+	}
+	slog.Info("metrics chart exported", "path", path)
+	return nil
+}
+
+// parseDayDuration parses the value of flag given as a Go duration or a whole number of days (e.g. 7d)
+func parseDayDuration(flag, value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid --%s %q: use a number of days like 7d or a duration like 12h", flag, value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid --%s %q: use a number of days like 7d or a duration like 12h", flag, value)
+	}
+	return d, nil
+}
+
+// dispatchAlert sends an alert in the background so retries never stall the display
+func dispatchAlert(alert monitor.Alert, alerters []monitor.Alerter) {
+	slog.Warn("alert fired", "resource", alert.Subject(), "level", alert.Level, "value", alert.Value, "threshold", alert.Threshold)
+	for _, alerter := range alerters {
+		go func(a monitor.Alerter) {
+			if err := a.Send(alert); err != nil {
+				slog.Error("failed to send alert", "resource", alert.Subject(), "error", err)
+			}
+		}(alerter)
+	}
+}
+
+func init() {
+	serverCmd.AddCommand(monitorCmd, alertsCmd, alertSummaryCmd, sshCmd, serverTopCmd, cpuGovernorCmd)
+
+	monitorCmd.Flags().DurationVarP(&monitorInterval, "interval", "i", 2*time.Second, "Refresh interval")
+	monitorCmd.Flags().Float64Var(&monitorCPUThreshold, "cpu-threshold", 90, "CPU usage percent that triggers an alert (0 disables)")
+	monitorCmd.Flags().Float64Var(&monitorMemoryThreshold, "memory-threshold", 90, "Memory usage percent that triggers an alert (0 disables)")
+	monitorCmd.Flags().Float64Var(&monitorDiskThreshold, "disk-threshold", 90, "Root disk usage percent that triggers an alert (0 disables)")
+	monitorCmd.Flags().StringVar(&monitorWebhook, "webhook", "", "URL to POST alerts to (overrides the saved webhook)")
+	monitorCmd.Flags().StringArrayVar(&monitorWatchProcesses, "watch-process", []string{}, "Highlight processes whose name matches this glob pattern (repeatable)")
+	monitorCmd.Flags().StringArrayVar(&monitorDiskPaths, "disk-path", []string{}, "Report disk I/O only for the device backing this mountpoint (repeatable)")
+	monitorCmd.Flags().BoolVar(&monitorAlertHistory, "alert-history", false, "Show past alerts instead of live metrics")
+	monitorCmd.Flags().DurationVar(&monitorHistoryWindow, "last", 24*time.Hour, "How far back --alert-history looks")
+	monitorCmd.Flags().StringVar(&monitorExportCSV, "export-csv", "", "Also write the --alert-history results to this CSV file")
+	monitorCmd.Flags().StringVar(&monitorWebhookSecret, "webhook-secret", "", "Secret used to sign webhook payloads (X-Finks-Signature)")
+	monitorCmd.Flags().BoolVar(&monitorShowUser, "show-user", false, "Show the owner of each process")
+	monitorCmd.Flags().StringVar(&monitorFilterUser, "filter-user", "", "Only list processes owned by this user name or UID")
+	monitorCmd.Flags().StringSliceVar(&monitorProcessFilter, "process-filter", []string{}, "Only list processes whose name matches one of these glob patterns (comma-separated)")
+	monitorCmd.Flags().StringSliceVar(&monitorProcessExclude, "process-exclude", []string{}, "Hide processes whose name matches one of these glob patterns (comma-separated)")
+	monitorCmd.Flags().StringArrayVar(&monitorDiskAlertPaths, "disk-alert-path", []string{}, "Alert when a partition reaches a threshold, as <mountpoint>:<percent> (repeatable)")
+	monitorCmd.Flags().StringVar(&monitorSnapshotDir, "save-screenshot", "", "Save each refresh as a plain-text snapshot in this directory")
+	monitorCmd.Flags().StringVar(&monitorRetention, "retention", "7d", "Delete snapshots older than this (e.g. 7d, 12h; 0 keeps all)")
+	monitorCmd.Flags().IntVar(&monitorReceivePort, "alert-receive-port", 0, "Accept alerts from other finks instances on this port and show them (0 disables)")
+	monitorCmd.Flags().Float64Var(&monitorHighlight.Low, "highlight-low", monitor.DefaultColorThresholds.Low, "Usage percent from which values are shown in yellow")
+	monitorCmd.Flags().Float64Var(&monitorHighlight.Medium, "highlight-medium", monitor.DefaultColorThresholds.Medium, "Usage percent from which values are shown in orange")
+	monitorCmd.Flags().Float64Var(&monitorHighlight.High, "highlight-high", monitor.DefaultColorThresholds.High, "Usage percent from which values are shown in red")
+	monitorCmd.Flags().BoolVar(&monitorPerCPU, "per-cpu", false, "Show a sparkline of the last 20 samples for each CPU core")
+	monitorCmd.Flags().StringVar(&monitorFormat, "format", monitorFormatFull, "Display format (full, compact)")
+	monitorCmd.Flags().StringVar(&monitorCompactSep, "compact-separator", " | ", "Separator between fields in --format compact")
+	monitorCmd.Flags().BoolVar(&monitorRecord, "record", false, "Append each refresh to ~/.finks/metrics.jsonl")
+	monitorCmd.Flags().StringVar(&monitorRecordRetention, "record-retention", "7d", "Drop recorded samples older than this (e.g. 7d, 12h; 0 keeps all)")
+	monitorCmd.Flags().BoolVar(&monitorExportSVG, "export-svg", false, "Periodically draw the recorded metrics as an SVG chart in ~/.finks/charts (requires --record)")
+	monitorCmd.Flags().DurationVar(&monitorExportEvery, "export-every", time.Hour, "How often --export-svg writes a chart, and the period each chart covers")
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/monitor"
+	"github.com/pterm/pterm"
+)
+
+// showAlertHistory prints the alerts fired within window, newest first, and
+// optionally writes them to a CSV file
+func showAlertHistory(window time.Duration, csvPath string) error {
+	alerts, err := monitor.LoadAlertHistory(time.Now().Add(-window))
+	if err != nil {
+		return fmt.Errorf("failed to load alert history: %w", err)
+	}
+
+	if len(alerts) == 0 {
+		pterm.Info.Println(fmt.Sprintf("No alerts in the last %s", window))
+	} else {
+		tableData := pterm.TableData{{"TIMESTAMP", "LEVEL", "RESOURCE", "VALUE", "THRESHOLD"}}
+		for _, alert := range alerts {
+			level := pterm.FgYellow.Sprint(alert.Level)
+			if alert.Level == monitor.LevelCritical {
+				level = pterm.FgRed.Sprint(alert.Level)
+			}
+			tableData = append(tableData, []string{
+				alert.Timestamp.Local().Format("2006-01-02 15:04:05"),
+				level,
+				alert.Subject(),
+				fmt.Sprintf("%.1f%%", alert.Value),
+				fmt.Sprintf("%.0f%%", alert.Threshold),
+			})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+	}
+
+	if csvPath == "" {
+		return nil
+	}
+	if err := writeAlertsCSV(csvPath, alerts); err != nil {
+		return err
+	}
+	pterm.Success.Println(fmt.Sprintf("Exported %d alert(s) to %s", len(alerts), csvPath))
+	return nil
+}
+
+func writeAlertsCSV(path string, alerts []monitor.Alert) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"timestamp", "level", "resource", "value", "threshold", "hostname", "message"})
+	for _, alert := range alerts {
+		w.Write([]string{
+			alert.Timestamp.Format(time.RFC3339),
+			alert.Level,
+			alert.Subject(),
+			strconv.FormatFloat(alert.Value, 'f', 2, 64),
+			strconv.FormatFloat(alert.Threshold, 'f', 2, 64),
+			alert.Hostname,
+			alert.Message,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV file: %w", err)
+	}
+	return nil
+}
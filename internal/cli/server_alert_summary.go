@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/monitor"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+const (
+	summaryFormatTable = "table"
+	summaryFormatEmail = "email"
+)
+
+var (
+	summaryPeriod string
+	summaryFormat string
+)
+
+var alertSummaryCmd = &cobra.Command{
+	Use:   "alert-summary",
+	Short: "Summarize threshold breaches over a period",
+	Long: `Summarize the alerts fired by 'finks server monitor' over a period, grouped by resource.
+
+For every resource the summary shows how many times it crossed its threshold, the
+longest and average time it stayed there, and its peak value. Resources are ordered
+from the most to the least problematic, and the first one is called out as the headline.
+
+Durations come from breaches that have ended while the monitor was running, so they
+are only available for breaches recorded by this version of finks.
+
+The email format prints a plain text report that can be piped to a mail command.
+
+Examples:
+  finks server alert-summary
+  finks server alert-summary --period 24h
+  finks server alert-summary --period 30d --format email
+
+  # Weekly report from cron, every Monday at 08:00
+  0 8 * * 1 finks server alert-summary --period 7d --format email | mail -s "Finks Weekly Report" ops@example.com`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if summaryFormat != summaryFormatTable && summaryFormat != summaryFormatEmail {
+			return fmt.Errorf("invalid --format %q: must be %s or %s", summaryFormat, summaryFormatTable, summaryFormatEmail)
+		}
+		period, err := parseDayDuration("period", summaryPeriod)
+		if err != nil {
+			return err
+		}
+		if period == 0 {
+			return fmt.Errorf("--period must be longer than 0")
+		}
+
+		since := time.Now().Add(-period)
+		alerts, err := monitor.LoadAlertHistory(since)
+		if err != nil {
+			return fmt.Errorf("failed to load alert history: %w", err)
+		}
+		breaches, err := monitor.LoadBreachHistory(since)
+		if err != nil {
+			return fmt.Errorf("failed to load breach history: %w", err)
+		}
+		summaries := monitor.SummarizeAlerts(alerts, breaches)
+
+		if summaryFormat == summaryFormatEmail {
+			fmt.Print(alertSummaryEmail(summaries, summaryPeriod, since))
+			return nil
+		}
+
+		if len(summaries) == 0 {
+			pterm.Info.Println(fmt.Sprintf("No threshold breaches in the last %s", summaryPeriod))
+			return nil
+		}
+
+		pterm.Warning.Println(alertSummaryHeadline(summaries[0]))
+		tableData := pterm.TableData{{"RESOURCE", "BREACHES", "LONGEST", "AVERAGE", "PEAK", "PEAK AT"}}
+		for _, summary := range summaries {
+			tableData = append(tableData, []string{
+				summary.Subject,
+				fmt.Sprintf("%d", summary.Breaches),
+				formatBreachDuration(summary.Longest),
+				formatBreachDuration(summary.Average),
+				fmt.Sprintf("%.1f%%", summary.Peak),
+				formatPeakTime(summary.PeakAt),
+			})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+func init() {
+	alertSummaryCmd.Flags().StringVar(&summaryPeriod, "period", "7d", "Period to summarize, as a number of days (7d) or a duration (12h)")
+	alertSummaryCmd.Flags().StringVar(&summaryFormat, "format", summaryFormatTable, "Output format: table or email")
+}
+
+func alertSummaryHeadline(summary monitor.AlertSummary) string {
+	headline := fmt.Sprintf("Most problematic: %s with %d breach(es)", summary.Subject, summary.Breaches)
+	if summary.Longest > 0 {
+		headline += fmt.Sprintf(", the longest lasting %s", formatBreachDuration(summary.Longest))
+	}
+	return headline
+}
+
+// alertSummaryEmail renders the summary as a plain text report
+func alertSummaryEmail(summaries []monitor.AlertSummary, period string, since time.Time) string {
+	hostname, _ := os.Hostname()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Finks alert summary for %s\n", hostname)
+	fmt.Fprintf(&b, "Period: last %s (since %s)\n\n", period, since.Local().Format("2006-01-02 15:04"))
+
+	if len(summaries) == 0 {
+		b.WriteString("No threshold breaches in this period.\n")
+		return b.String()
+	}
+
+	b.WriteString(alertSummaryHeadline(summaries[0]) + ".\n")
+	for _, summary := range summaries {
+		fmt.Fprintf(&b, "\n%s\n", strings.ToUpper(summary.Subject))
+		fmt.Fprintf(&b, "  Breaches:          %d\n", summary.Breaches)
+		fmt.Fprintf(&b, "  Longest breach:    %s\n", formatBreachDuration(summary.Longest))
+		fmt.Fprintf(&b, "  Average duration:  %s\n", formatBreachDuration(summary.Average))
+		fmt.Fprintf(&b, "  Peak:              %.1f%% at %s\n", summary.Peak, formatPeakTime(summary.PeakAt))
+	}
+	return b.String()
+}
+
+func formatBreachDuration(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return d.Round(time.Minute).String()
+}
+
+func formatPeakTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
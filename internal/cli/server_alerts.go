@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/monitor"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var alertsCmd = &cobra.Command{
+	Use:   "alerts",
+	Short: "Configure alert notifications",
+	Long:  `Commands for configuring where monitoring alerts are delivered.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var alertsWebhookCmd = &cobra.Command{
+	Use:   "webhook <url>",
+	Short: "Send alerts to a webhook",
+	Long: `Save a webhook URL that 'finks server monitor' posts alerts to as JSON.
+With --secret, each request carries an HMAC-SHA256 signature of the body in the
+X-Finks-Signature header. Pass an empty URL ("") to remove the webhook.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		secret, _ := cmd.Flags().GetString("secret")
+
+		settings, err := monitor.LoadAlertSettings()
+		if err != nil {
+			return fmt.Errorf("failed to load alert settings: %w", err)
+		}
+
+		settings.WebhookURL = args[0]
+		settings.WebhookSecret = secret
+		if settings.WebhookURL == "" {
+			settings.WebhookSecret = ""
+		}
+
+		if err := monitor.SaveAlertSettings(settings); err != nil {
+			return fmt.Errorf("failed to save alert settings: %w", err)
+		}
+
+		if settings.WebhookURL == "" {
+			pterm.Success.Println("Alert webhook removed")
+		} else {
+			pterm.Success.Println(fmt.Sprintf("Alerts will be sent to %s", settings.WebhookURL))
+		}
+		return nil
+	},
+}
+
+var alertsTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Send a test alert through the configured channels",
+	Long: `Send a synthetic alert through every configured alert channel to verify the
+integration. No monitoring needs to be running. Use --webhook to test a URL
+without saving it.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		webhookURL, _ := cmd.Flags().GetString("webhook")
+		webhookSecret, _ := cmd.Flags().GetString("secret")
+
+		alerters, err := configuredAlerters(webhookURL, webhookSecret)
+		if err != nil {
+			return err
+		}
+		if len(alerters) == 0 {
+			return fmt.Errorf("no alert channels configured; run 'finks server alerts webhook <url>' or pass --webhook")
+		}
+
+		hostname, _ := os.Hostname()
+		alert := monitor.Alert{
+			Level:     monitor.LevelTest,
+			Resource:  "test",
+			Message:   "This is a test alert from finks",
+			Hostname:  hostname,
+			Timestamp: time.Now(),
+		}
+
+		spinner, _ := pterm.DefaultSpinner.Start("Sending test alert...")
+		for _, alerter := range alerters {
+			if err := alerter.Send(alert); err != nil {
+				spinner.Fail(fmt.Sprintf("Failed to send test alert: %v", err))
+				return fmt.Errorf("failed to send test alert: %w", err)
+			}
+		}
+
+		spinner.Success("Test alert delivered")
+		return nil
+	},
+}
+
+func init() {
+	alertsCmd.AddCommand(alertsWebhookCmd, alertsTestCmd)
+
+	alertsTestCmd.Flags().String("webhook", "", "Webhook URL to test instead of the saved one")
+	alertsTestCmd.Flags().String("secret", "", "Secret used to sign the test payload")
+
+	alertsWebhookCmd.Flags().String("secret", "", "Secret used to sign webhook payloads")
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/bimalpaudels/finks/internal/monitor"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var cpuGovernorCmd = &cobra.Command{
+	Use:   "cpu-governor",
+	Short: "Manage the Linux CPU frequency scaling governor",
+	Long: `Manage the Linux CPU frequency scaling governor. The current governor and
+frequency are shown in the System Overview of 'finks server monitor'.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var setCPUGovernorCmd = &cobra.Command{
+	Use:   "set <governor>",
+	Short: "Set the scaling governor of every CPU core",
+	Long: `Set the scaling governor of every CPU core, e.g. performance to keep cores at
+their highest frequency or powersave to keep them at their lowest. Only governors
+the kernel offers are accepted. This writes to /sys and has to be run as root.
+
+Examples:
+  sudo finks server cpu-governor set performance
+  sudo finks server cpu-governor set ondemand`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"performance", "powersave", "ondemand", "conservative", "schedutil"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		governor := args[0]
+
+		cores, err := monitor.SetCPUGovernor(governor)
+		if err != nil {
+			return fmt.Errorf("failed to set CPU governor: %w", err)
+		}
+
+		pterm.Success.Println(fmt.Sprintf("Set the governor of %d CPU core(s) to %s", cores, governor))
+		return nil
+	},
+}
+
+func init() {
+	cpuGovernorCmd.AddCommand(setCPUGovernorCmd)
+}
@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/bimalpaudels/finks/internal/remote"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	sshKeyPath string
+	sshSocket  string
+)
+
+var sshCmd = &cobra.Command{
+	Use:   "ssh <user@host>",
+	Short: "Manage a remote finks host over SSH",
+	Long: `Connect to a remote host over SSH and tunnel its Docker socket to a local
+temporary socket. An interactive shell then runs finks commands against the
+remote Docker daemon until you type 'exit'.
+
+The host key must already be in ~/.ssh/known_hosts. Without --key, keys from
+ssh-agent and ~/.ssh are tried.
+
+Examples:
+  finks server ssh deploy@example.com
+  finks server ssh deploy@example.com:2222 --key ~/.ssh/finks_ed25519`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target := args[0]
+
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Connecting to %s...", target))
+		tunnel, err := remote.Dial(remote.Options{
+			Target:       target,
+			KeyPath:      sshKeyPath,
+			RemoteSocket: sshSocket,
+		})
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to connect: %v", err))
+			return fmt.Errorf("failed to connect to %s: %w", target, err)
+		}
+		defer tunnel.Close()
+
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+		defer stop()
+
+		// Ctrl+C is meant for the running command, not the shell; catching it here
+		// keeps the shell alive while children still get the default behaviour
+		interrupts := make(chan os.Signal, 1)
+		signal.Notify(interrupts, os.Interrupt)
+		defer signal.Stop(interrupts)
+		go func() {
+			for range interrupts {
+			}
+		}()
+		go tunnel.Serve(ctx)
+
+		spinner.Success(fmt.Sprintf("Connected to %s", target))
+		pterm.Info.Println(fmt.Sprintf("DOCKER_HOST=%s", tunnel.DockerHost()))
+		pterm.Info.Println("Type finks commands without the 'finks' prefix, e.g. 'app list'. Type 'exit' to disconnect.")
+
+		if err := os.Setenv("DOCKER_HOST", tunnel.DockerHost()); err != nil {
+			return fmt.Errorf("failed to set DOCKER_HOST: %w", err)
+		}
+
+		return runRemoteShell(ctx, target)
+	},
+}
+
+// runRemoteShell reads finks commands from stdin and runs each in a child process
+// that inherits DOCKER_HOST, so every command starts from a clean state. Children
+// get no stdin, since the shell keeps reading it.
+func runRemoteShell(ctx context.Context, target string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate finks executable: %w", err)
+	}
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	for {
+		fmt.Printf("finks@%s> ", target)
+
+		var line string
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return nil
+		case l, ok := <-lines:
+			if !ok {
+				fmt.Println()
+				return nil
+			}
+			line = l
+		}
+
+		fields, err := splitShellWords(line)
+		if err != nil {
+			pterm.Error.Println(err.Error())
+			continue
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "finks" {
+			fields = fields[1:]
+		}
+		if len(fields) == 1 && (fields[0] == "exit" || fields[0] == "quit") {
+			return nil
+		}
+
+		child := exec.CommandContext(ctx, executable, fields...)
+		child.Stdout = os.Stdout
+		child.Stderr = os.Stderr
+		if err := child.Run(); err != nil && ctx.Err() == nil {
+			pterm.Error.Println(err.Error())
+		}
+	}
+}
+
+// splitShellWords splits a command line into arguments the way a POSIX shell does
+// for plain words: single quotes keep everything literally, double quotes keep
+// everything but backslash escapes of " and \, and a backslash outside quotes
+// escapes the next character. Variables and globs are not expanded.
+func splitShellWords(line string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			if quote == '"' && r != '"' && r != '\\' {
+				word.WriteRune('\\')
+			}
+			word.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if escaped {
+		return nil, fmt.Errorf("line ends with an unfinished backslash escape")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+func init() {
+	sshCmd.Flags().StringVar(&sshKeyPath, "key", "", "Path to the SSH identity file")
+	sshCmd.Flags().StringVar(&sshSocket, "socket", remote.DefaultDockerSocket, "Docker socket path on the remote host")
+}
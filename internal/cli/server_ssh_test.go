@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitShellWords(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"app list", []string{"app", "list"}},
+		{"  app\tlist  ", []string{"app", "list"}},
+		{`app inspect web --format '{{.Image}}'`, []string{"app", "inspect", "web", "--format", "{{.Image}}"}},
+		{`app deploy web nginx --env "MSG=hello world"`, []string{"app", "deploy", "web", "nginx", "--env", "MSG=hello world"}},
+		{`app env import web --prefix ''`, []string{"app", "env", "import", "web", "--prefix", ""}},
+		{`--env MSG="hello world"`, []string{"--env", "MSG=hello world"}},
+		{`"say \"hi\"" 'it''s' back\ slash`, []string{`say "hi"`, "its", "back slash"}},
+		{`"a\b" 'c\d'`, []string{`a\b`, `c\d`}},
+	}
+
+	for _, tt := range tests {
+		got, err := splitShellWords(tt.line)
+		require.NoError(t, err, tt.line)
+		assert.Equal(t, tt.want, got, tt.line)
+	}
+}
+
+func TestSplitShellWordsErrors(t *testing.T) {
+	for _, line := range []string{`app inspect '{{.Image}}`, `--env "MSG=hello`, `app list \`} {
+		_, err := splitShellWords(line)
+		assert.Error(t, err, line)
+	}
+}
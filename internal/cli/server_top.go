@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/monitor"
+	"github.com/docker/go-units"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var (
+	topFilterUser string
+	topLimit      int
+	topSample     time.Duration
+)
+
+var serverTopCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show the processes using the most CPU",
+	Long: `Show the processes using the most CPU, with their owners. CPU usage is measured
+over a short sampling window.
+
+Examples:
+  finks server top
+  finks server top --filter-user www-data
+  finks server top --limit 20 --sample 3s`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if topLimit < 1 {
+			return fmt.Errorf("--limit must be at least 1")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), topSample+30*time.Second)
+		defer cancel()
+
+		service := monitor.NewMetricsService(monitor.MetricsOptions{
+			Users:      true,
+			FilterUser: topFilterUser,
+			TopCount:   topLimit,
+		})
+
+		// CPU usage is a rate, so it needs two samples
+		if _, err := service.GetProcessMetrics(ctx); err != nil {
+			return fmt.Errorf("failed to collect process metrics: %w", err)
+		}
+		time.Sleep(topSample)
+		metrics, err := service.GetProcessMetrics(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to collect process metrics: %w", err)
+		}
+
+		if len(metrics.TopCPU) == 0 {
+			if topFilterUser != "" {
+				pterm.Info.Printf("No active processes owned by %s\n", topFilterUser)
+			} else {
+				pterm.Info.Println("No active processes")
+			}
+			return nil
+		}
+
+		tableData := pterm.TableData{{"PID", "USER", "NAME", "CPU%", "MEM%", "RSS"}}
+		for _, proc := range metrics.TopCPU {
+			tableData = append(tableData, []string{
+				strconv.Itoa(int(proc.PID)),
+				proc.Username,
+				proc.Name,
+				fmt.Sprintf("%.1f", proc.CPUPercent),
+				fmt.Sprintf("%.1f", proc.MemoryPercent),
+				units.BytesSize(float64(proc.MemoryRSS)),
+			})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		return nil
+	},
+}
+
+func init() {
+	serverTopCmd.Flags().StringVar(&topFilterUser, "filter-user", "", "Only show processes owned by this user name or UID")
+	serverTopCmd.Flags().IntVarP(&topLimit, "limit", "n", 10, "Number of processes to show")
+	serverTopCmd.Flags().DurationVar(&topSample, "sample", time.Second, "How long to measure CPU usage for")
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/bimalpaudels/finks/internal/version"
+	"github.com/spf13/cobra"
+)
+
+var versionShort bool
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print finks version information",
+	Long:  `Print the finks version along with the git commit, build date, Go version and platform.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if versionShort {
+			fmt.Println(version.Version)
+			return
+		}
+
+		fmt.Printf("finks version: %s\n", version.Version)
+		fmt.Printf("Git commit:    %s\n", version.Commit)
+		fmt.Printf("Build date:    %s\n", version.Date)
+		fmt.Printf("Go version:    %s\n", runtime.Version())
+		fmt.Printf("OS/Arch:       %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	},
+}
+
+func init() {
+	versionCmd.Flags().BoolVar(&versionShort, "short", false, "Print only the version number")
+}
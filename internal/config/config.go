@@ -3,11 +3,38 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+// EnvConfigPath is the environment variable that overrides the config file location
+const EnvConfigPath = "FINKS_CONFIG"
+
+const maskedValue = "****"
+
+// ResolvePath returns the config file to load: the explicit path if given, then
+// $FINKS_CONFIG, then ~/.finks/config.yaml if it exists. An empty result means defaults only.
+func ResolvePath(explicit string) string {
+	if explicit != "" {
+		return explicit
+	}
+	if envPath := os.Getenv(EnvConfigPath); envPath != "" {
+		return envPath
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	defaultPath := filepath.Join(homeDir, ".finks", "config.yaml")
+	if _, err := os.Stat(defaultPath); err != nil {
+		return ""
+	}
+	return defaultPath
+}
+
 func Load(configPath string) (*Config, error) {
 	// Set defaults
 	config := &Config{
@@ -42,3 +69,11 @@ func Load(configPath string) (*Config, error) {
 
 	return config, nil
 }
+
+// Redacted returns a copy of the config with sensitive values masked
+func (c Config) Redacted() Config {
+	if c.Docker.RegistryPassword != "" {
+		c.Docker.RegistryPassword = maskedValue
+	}
+	return c
+}
@@ -3,28 +3,30 @@ package config
 import "time"
 
 type Config struct {
-	Deployment DeploymentConfig `yaml:"deployment"`
-	Monitoring MonitoringConfig `yaml:"monitoring"`
-	Docker     DockerConfig     `yaml:"docker"`
-	Logging    LoggingConfig    `yaml:"logging"`
+	Deployment DeploymentConfig `yaml:"deployment" json:"deployment"`
+	Monitoring MonitoringConfig `yaml:"monitoring" json:"monitoring"`
+	Docker     DockerConfig     `yaml:"docker" json:"docker"`
+	Logging    LoggingConfig    `yaml:"logging" json:"logging"`
 }
 
 type DeploymentConfig struct {
-	DataDir string `yaml:"data_dir"`
+	DataDir string `yaml:"data_dir" json:"data_dir"`
 }
 
 type MonitoringConfig struct {
-	MetricsInterval     time.Duration `yaml:"metrics_interval"`
-	HealthCheckInterval time.Duration `yaml:"health_check_interval"`
+	MetricsInterval     time.Duration `yaml:"metrics_interval" json:"metrics_interval"`
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" json:"health_check_interval"`
 }
 
 type DockerConfig struct {
-	Socket   string `yaml:"socket"`
-	Network  string `yaml:"network"`
-	Registry string `yaml:"registry"`
+	Socket           string `yaml:"socket" json:"socket"`
+	Network          string `yaml:"network" json:"network"`
+	Registry         string `yaml:"registry" json:"registry"`
+	RegistryUsername string `yaml:"registry_username,omitempty" json:"registry_username,omitempty"`
+	RegistryPassword string `yaml:"registry_password,omitempty" json:"registry_password,omitempty"`
 }
 
 type LoggingConfig struct {
-	Level  string `yaml:"level"`
-	Format string `yaml:"format"`
+	Level  string `yaml:"level" json:"level"`
+	Format string `yaml:"format" json:"format"`
 }
@@ -0,0 +1,143 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// oomRecheckInterval is how often WatchOOM reloads apps.json to pick up changes
+const oomRecheckInterval = time.Minute
+
+// SetAutoRestartOnOOM turns restarting an application after an out-of-memory kill
+// on or off. The restarts are done by WatchOOM.
+func (m *Manager) SetAutoRestartOnOOM(ctx context.Context, name string, enabled bool) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	app.AutoRestartOnOOM = enabled
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("auto-restart on OOM updated", "app", name, "enabled", enabled)
+	return nil
+}
+
+// oomWatcher is the event watcher of one application
+type oomWatcher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// WatchOOM restarts applications with AutoRestartOnOOM set when their container is
+// killed for running out of memory, until ctx is cancelled. apps.json is reloaded
+// every minute, so watchers start and stop as the setting is changed.
+func (m *Manager) WatchOOM(ctx context.Context) error {
+	watchers := make(map[string]*oomWatcher)
+	defer func() {
+		for _, w := range watchers {
+			w.cancel()
+		}
+	}()
+
+	for {
+		enabled := make(map[string]bool)
+		for name, app := range m.config.Apps {
+			if app.AutoRestartOnOOM {
+				enabled[name] = true
+			}
+		}
+
+		for name, w := range watchers {
+			select {
+			case <-w.done:
+				// The event stream ended, e.g. because the container was recreated
+				delete(watchers, name)
+				continue
+			default:
+			}
+			if !enabled[name] {
+				slog.Info("stopped OOM watcher", "app", name)
+				w.cancel()
+				delete(watchers, name)
+			}
+		}
+		for name := range enabled {
+			if _, ok := watchers[name]; ok {
+				continue
+			}
+			watchCtx, cancel := context.WithCancel(ctx)
+			w := &oomWatcher{cancel: cancel, done: make(chan struct{})}
+			if err := m.watchOOM(watchCtx, name, w.done); err != nil {
+				cancel()
+				slog.Error("failed to watch for OOM kills", "app", name, "error", err)
+				continue
+			}
+			slog.Info("started OOM watcher", "app", name)
+			watchers[name] = w
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(oomRecheckInterval):
+		}
+
+		if err := m.reloadConfig(); err != nil {
+			slog.Error("failed to reload config", "error", err)
+		}
+	}
+}
+
+// watchOOM starts a container once it dies after an OOM event. done is closed when
+// the event stream ends.
+func (m *Manager) watchOOM(ctx context.Context, name string, done chan struct{}) error {
+	containerName := fmt.Sprintf("finks-%s", name)
+	events, err := m.dockerClient.WatchContainerEvents(ctx, containerName, "")
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(done)
+		oomKilled := false
+		for event := range events {
+			switch event.Action {
+			case "oom":
+				slog.Warn("application ran out of memory", "app", name)
+				oomKilled = true
+			case "die":
+				if !oomKilled {
+					continue
+				}
+				oomKilled = false
+				// The container is only started once it has stopped, otherwise the start is a no-op
+				if err := m.dockerClient.StartContainer(ctx, containerName); err != nil {
+					slog.Error("failed to restart application after OOM kill", "app", name, "error", err)
+					continue
+				}
+				slog.Info("application restarted after OOM kill", "app", name)
+			}
+		}
+	}()
+	return nil
+}
+
+// reloadConfig replaces the in-memory config with the current apps.json
+func (m *Manager) reloadConfig() error {
+	m.config = &Config{
+		Apps:    make(map[string]*App),
+		DataDir: m.config.DataDir,
+	}
+	return m.loadConfig()
+}
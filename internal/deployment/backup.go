@@ -0,0 +1,131 @@
+package deployment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BackupOptions controls how an application's volumes are backed up
+type BackupOptions struct {
+	OutputDir string
+	// StopBeforeBackup stops the container instead of pausing it, so databases flush to disk
+	StopBeforeBackup bool
+}
+
+// BackupResult describes a written backup archive
+type BackupResult struct {
+	Path   string
+	Size   int64
+	SHA256 string
+}
+
+// BackupApp archives all of an application's volumes into <dir>/<app>_<timestamp>.tar.gz.
+// A running container is paused (or stopped) for the duration of the backup and resumed afterwards.
+func (m *Manager) BackupApp(ctx context.Context, name string, opts BackupOptions) (*BackupResult, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	mounts := backupMounts(app.Volumes)
+	if len(mounts) == 0 {
+		return nil, fmt.Errorf("application %s has no volumes to back up", name)
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	if app.Status == StatusRunning {
+		resume, err := m.quiesceContainer(ctx, containerName, opts.StopBeforeBackup)
+		if err != nil {
+			slog.Error("failed to quiesce container", "app", name, "error", err)
+			return nil, fmt.Errorf("failed to quiesce container: %w", err)
+		}
+		defer func() {
+			if err := resume(); err != nil {
+				slog.Error("failed to resume container after backup", "app", name, "error", err)
+			}
+		}()
+	}
+
+	path := filepath.Join(opts.OutputDir, fmt.Sprintf("%s_%s.tar.gz", name, time.Now().Format("20060102-150405")))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup file: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if err := m.dockerClient.ArchiveVolumes(ctx, mounts, io.MultiWriter(file, hash)); err != nil {
+		os.Remove(path)
+		slog.Error("failed to archive volumes", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to archive volumes: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write backup file: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat backup file: %w", err)
+	}
+
+	slog.Info("application backed up", "app", name, "path", path, "size", info.Size())
+	return &BackupResult{
+		Path:   path,
+		Size:   info.Size(),
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// quiesceContainer pauses or stops a container and returns a function that resumes it
+func (m *Manager) quiesceContainer(ctx context.Context, containerName string, stop bool) (func() error, error) {
+	// Resume with a fresh context so a cancelled backup still brings the app back
+	resumeCtx := context.WithoutCancel(ctx)
+
+	if stop {
+		if err := m.dockerClient.StopContainer(ctx, containerName); err != nil {
+			return nil, err
+		}
+		return func() error { return m.dockerClient.StartContainer(resumeCtx, containerName) }, nil
+	}
+
+	if err := m.dockerClient.PauseContainer(ctx, containerName); err != nil {
+		return nil, err
+	}
+	return func() error { return m.dockerClient.UnpauseContainer(resumeCtx, containerName) }, nil
+}
+
+// backupMounts maps each volume source to a directory name in the archive derived
+// from its mount path, e.g. /var/lib/postgresql/data becomes var_lib_postgresql_data
+func backupMounts(volumes []string) map[string]string {
+	mounts := make(map[string]string, len(volumes))
+	for _, volume := range volumes {
+		parts := strings.Split(volume, ":")
+		if len(parts) < 2 {
+			continue
+		}
+		source, target := parts[0], parts[1]
+		name := strings.ReplaceAll(strings.Trim(target, "/"), "/", "_")
+		if name == "" {
+			name = "root"
+		}
+		mounts[source] = name
+	}
+	return mounts
+}
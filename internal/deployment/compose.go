@@ -0,0 +1,341 @@
+package deployment
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"gopkg.in/yaml.v3"
+)
+
+// composeFile is the subset of the Compose file format finks reads and writes
+type composeFile struct {
+	Services map[string]*composeService `yaml:"services"`
+	Networks map[string]composeResource `yaml:"networks,omitempty"`
+	Volumes  map[string]composeResource `yaml:"volumes,omitempty"`
+}
+
+type composeService struct {
+	Image       string          `yaml:"image,omitempty"`
+	Build       any             `yaml:"build,omitempty"`
+	Restart     string          `yaml:"restart,omitempty"`
+	Ports       []string        `yaml:"ports,omitempty"`
+	Environment composeMap      `yaml:"environment,omitempty"`
+	Volumes     []string        `yaml:"volumes,omitempty"`
+	Labels      composeMap      `yaml:"labels,omitempty"`
+	Networks    composeList     `yaml:"networks,omitempty"`
+	DependsOn   composeList     `yaml:"depends_on,omitempty"`
+	Logging     *composeLogging `yaml:"logging,omitempty"`
+}
+
+// composeMap accepts both the mapping and the KEY=VALUE list form Compose allows
+// for environment and labels
+type composeMap map[string]string
+
+func (m *composeMap) UnmarshalYAML(node *yaml.Node) error {
+	values := make(map[string]string)
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		if err := node.Decode(&values); err != nil {
+			return err
+		}
+	case yaml.SequenceNode:
+		var items []string
+		if err := node.Decode(&items); err != nil {
+			return err
+		}
+		for _, item := range items {
+			key, value, ok := strings.Cut(item, "=")
+			if !ok {
+				// A bare KEY takes its value from the environment, as Compose does
+				value = os.Getenv(key)
+			}
+			values[key] = value
+		}
+	default:
+		return fmt.Errorf("line %d: expected a mapping or a list", node.Line)
+	}
+
+	*m = values
+	return nil
+}
+
+// composeList accepts both a list of names and a mapping keyed by name, as used
+// by networks and depends_on
+type composeList []string
+
+func (l *composeList) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		var items []string
+		if err := node.Decode(&items); err != nil {
+			return err
+		}
+		*l = items
+	case yaml.MappingNode:
+		var items []string
+		for i := 0; i < len(node.Content); i += 2 {
+			items = append(items, node.Content[i].Value)
+		}
+		sort.Strings(items)
+		*l = items
+	default:
+		return fmt.Errorf("line %d: expected a mapping or a list", node.Line)
+	}
+	return nil
+}
+
+// ComposeService is a service read from a Compose file, ready to deploy
+type ComposeService struct {
+	Name    string
+	Options DeployOptions
+	// Build is set when the service builds its own image, which finks cannot do
+	Build bool
+	// Warnings lists settings that could not be carried over
+	Warnings []string
+}
+
+type composeLogging struct {
+	Driver  string            `yaml:"driver"`
+	Options map[string]string `yaml:"options,omitempty"`
+}
+
+// composeResource declares a network or volume under its exact Docker name, so Compose
+// reuses the existing one instead of creating a project-prefixed copy
+type composeResource struct {
+	Name string `yaml:"name"`
+}
+
+// GenerateCompose exports an application as a Compose file that `docker compose up`
+// can run as is. Traefik labels are kept, so a routed app stays routed.
+func (m *Manager) GenerateCompose(name string) ([]byte, error) {
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	service := &composeService{
+		Image:       app.Image,
+		Restart:     app.RestartPolicy,
+		Environment: escapeComposeValues(app.EnvVars),
+		Volumes:     app.Volumes,
+		Labels:      escapeComposeValues(app.Labels),
+		Networks:    app.Networks,
+	}
+	if service.Restart == "" {
+		service.Restart = docker.DefaultRestartPolicy
+	}
+	if app.Port != "" {
+		service.Ports = []string{app.Port}
+	}
+	if app.LogDriver != "" {
+		service.Logging = &composeLogging{Driver: app.LogDriver, Options: app.LogOptions}
+	}
+
+	file := composeFile{
+		Services: map[string]*composeService{app.Name: service},
+	}
+
+	for _, network := range app.Networks {
+		if file.Networks == nil {
+			file.Networks = make(map[string]composeResource)
+		}
+		file.Networks[network] = composeResource{Name: network}
+	}
+
+	for _, volume := range app.Volumes {
+		source, _, _ := strings.Cut(volume, ":")
+		if !isNamedVolume(source) {
+			continue
+		}
+		if file.Volumes == nil {
+			file.Volumes = make(map[string]composeResource)
+		}
+		file.Volumes[source] = composeResource{Name: source}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(file); err != nil {
+		return nil, fmt.Errorf("failed to encode compose file: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode compose file: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// escapeComposeValues doubles '$' so Compose does not treat values as variable references
+func escapeComposeValues(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	escaped := make(map[string]string, len(values))
+	for key, value := range values {
+		escaped[key] = strings.ReplaceAll(value, "$", "$$")
+	}
+	return escaped
+}
+
+// isNamedVolume reports whether a volume source is a Docker volume name rather than a host path
+func isNamedVolume(source string) bool {
+	return source != "" && !strings.HasPrefix(source, "/") && !strings.HasPrefix(source, ".") && !strings.HasPrefix(source, "~")
+}
+
+// resolveBindMount makes a relative bind mount source absolute. Bind mounts need
+// absolute paths, so relative ones are resolved against the directory of the file
+// that declared them, as Compose does.
+func resolveBindMount(volume, baseDir string) string {
+	source, rest, found := strings.Cut(volume, ":")
+	if found && strings.HasPrefix(source, ".") {
+		return filepath.Join(baseDir, source) + ":" + rest
+	}
+	return volume
+}
+
+// ParseComposeFile reads the services of a Compose file, ordered so that every service
+// comes after the services it depends on
+func ParseComposeFile(path string) ([]ComposeService, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compose file: %w", err)
+	}
+
+	var file composeFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse compose file: %w", err)
+	}
+	if len(file.Services) == 0 {
+		return nil, fmt.Errorf("compose file %s defines no services", path)
+	}
+
+	order, err := composeOrder(file.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	baseDir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve compose file directory: %w", err)
+	}
+
+	services := make([]ComposeService, 0, len(order))
+	for _, name := range order {
+		services = append(services, file.toComposeService(name, baseDir))
+	}
+	return services, nil
+}
+
+// toComposeService converts a parsed service into deploy options
+func (f *composeFile) toComposeService(name, baseDir string) ComposeService {
+	svc := f.Services[name]
+	result := ComposeService{
+		Name:  name,
+		Build: svc.Build != nil,
+		Options: DeployOptions{
+			Name:          name,
+			Image:         svc.Image,
+			EnvVars:       unescapeComposeValues(svc.Environment),
+			Labels:        unescapeComposeValues(svc.Labels),
+			RestartPolicy: svc.Restart,
+		},
+	}
+
+	if len(svc.Ports) > 0 {
+		result.Options.Port = svc.Ports[0]
+		if len(svc.Ports) > 1 {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("only the first port mapping (%s) is published", svc.Ports[0]))
+		}
+	}
+
+	for _, volume := range svc.Volumes {
+		result.Options.Volumes = append(result.Options.Volumes, resolveBindMount(volume, baseDir))
+	}
+
+	for _, network := range svc.Networks {
+		// Top-level networks may give the Docker network a different name than the key
+		if declared, ok := f.Networks[network]; ok && declared.Name != "" {
+			network = declared.Name
+		}
+		result.Options.Networks = append(result.Options.Networks, network)
+	}
+
+	if svc.Logging != nil {
+		result.Options.LogDriver = svc.Logging.Driver
+		result.Options.LogOptions = svc.Logging.Options
+	}
+
+	return result
+}
+
+// composeOrder sorts services so dependencies come first. Services without a
+// dependency relation are kept in name order so the result is stable.
+func composeOrder(services map[string]*composeService) ([]string, error) {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(services))
+	var order []string
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular depends_on: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		svc, ok := services[name]
+		if !ok {
+			return fmt.Errorf("service %s depends on undefined service %s", path[len(path)-1], name)
+		}
+
+		state[name] = visiting
+		for _, dep := range svc.DependsOn {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = done
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// unescapeComposeValues reverses escapeComposeValues
+func unescapeComposeValues(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	unescaped := make(map[string]string, len(values))
+	for key, value := range values {
+		unescaped[key] = strings.ReplaceAll(value, "$$", "$")
+	}
+	return unescaped
+}
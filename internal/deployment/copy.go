@@ -0,0 +1,48 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+)
+
+// CopyVolume copies srcPath in srcApp's container to dstPath in dstApp's container,
+// streaming it as a tar archive. The destination container must be running. progress,
+// if set, is called with the number of bytes copied so far. It returns the total size.
+func (m *Manager) CopyVolume(ctx context.Context, srcApp, srcPath, dstApp, dstPath string, progress func(int64)) (int64, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return 0, err
+	}
+
+	for _, name := range []string{srcApp, dstApp} {
+		if _, exists := m.config.Apps[name]; !exists {
+			return 0, fmt.Errorf("application %s not found", name)
+		}
+	}
+	for _, p := range []string{srcPath, dstPath} {
+		if !path.IsAbs(p) || path.Clean(p) == "/" {
+			return 0, fmt.Errorf("invalid path %s: must be an absolute path other than /", p)
+		}
+	}
+
+	dstContainer := fmt.Sprintf("finks-%s", dstApp)
+	detail, err := m.dockerClient.ContainerInspect(ctx, dstContainer)
+	if err != nil {
+		slog.Error("failed to inspect container", "app", dstApp, "error", err)
+		return 0, fmt.Errorf("failed to inspect container: %w", err)
+	}
+	if detail.Status != "running" {
+		return 0, fmt.Errorf("application %s must be running to receive the copy; start it with 'finks app start %s'", dstApp, dstApp)
+	}
+
+	srcContainer := fmt.Sprintf("finks-%s", srcApp)
+	copied, err := m.dockerClient.CopyBetweenContainers(ctx, srcContainer, path.Clean(srcPath), dstContainer, path.Clean(dstPath), progress)
+	if err != nil {
+		slog.Error("failed to copy between containers", "src", srcApp, "dst", dstApp, "error", err)
+		return copied, fmt.Errorf("failed to copy %s: %w", srcPath, err)
+	}
+
+	slog.Info("path copied between applications", "src", srcApp, "src_path", srcPath, "dst", dstApp, "dst_path", dstPath, "bytes", copied)
+	return copied, nil
+}
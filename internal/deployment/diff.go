@@ -0,0 +1,113 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// FieldDiff describes a single difference between the live container and the stored app config
+type FieldDiff struct {
+	Field   string
+	Current string
+	Desired string
+}
+
+// DiffApp compares the live container against the stored application configuration
+// and returns every field that has drifted
+func (m *Manager) DiffApp(ctx context.Context, name string) ([]FieldDiff, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	detail, err := m.dockerClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to inspect container", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	return diffContainer(app, detail), nil
+}
+
+func diffContainer(app *App, detail *docker.ContainerDetail) []FieldDiff {
+	var diffs []FieldDiff
+
+	if detail.Image != app.Image {
+		diffs = append(diffs, FieldDiff{Field: "image", Current: detail.Image, Desired: app.Image})
+	}
+
+	// Containers inherit extra variables from their image, so only stored keys are compared
+	keys := make([]string, 0, len(app.EnvVars))
+	for key := range app.EnvVars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		current, ok := detail.Env[key]
+		if !ok {
+			diffs = append(diffs, FieldDiff{Field: "env " + key, Desired: key + "=" + app.EnvVars[key]})
+		} else if current != app.EnvVars[key] {
+			diffs = append(diffs, FieldDiff{
+				Field:   "env " + key,
+				Current: key + "=" + current,
+				Desired: key + "=" + app.EnvVars[key],
+			})
+		}
+	}
+
+	var desiredPorts []string
+	if app.Port != "" {
+		desiredPorts = []string{app.Port}
+	}
+	if !equalUnordered(detail.Ports, desiredPorts) {
+		diffs = append(diffs, FieldDiff{
+			Field:   "ports",
+			Current: strings.Join(detail.Ports, ", "),
+			Desired: strings.Join(desiredPorts, ", "),
+		})
+	}
+
+	if !equalUnordered(detail.Volumes, app.Volumes) {
+		diffs = append(diffs, FieldDiff{
+			Field:   "volumes",
+			Current: strings.Join(detail.Volumes, ", "),
+			Desired: strings.Join(app.Volumes, ", "),
+		})
+	}
+
+	restartPolicy := app.RestartPolicy
+	if restartPolicy == "" {
+		restartPolicy = docker.DefaultRestartPolicy
+	}
+	if detail.RestartPolicy != restartPolicy {
+		diffs = append(diffs, FieldDiff{
+			Field:   "restart policy",
+			Current: detail.RestartPolicy,
+			Desired: restartPolicy,
+		})
+	}
+
+	return diffs
+}
+
+func equalUnordered(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = slices.Clone(a)
+	b = slices.Clone(b)
+	sort.Strings(a)
+	sort.Strings(b)
+	return slices.Equal(a, b)
+}
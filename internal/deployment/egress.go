@@ -0,0 +1,269 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/bimalpaudels/finks/internal/proxy"
+)
+
+// egressNetworkName is the name given to the isolated network of an application
+func egressNetworkName(appName string) string {
+	return fmt.Sprintf("finks-%s-isolated", appName)
+}
+
+// egressNetwork returns the isolated network of an application with an egress policy.
+// Policies saved before the network was recorded were applied under the app's
+// name at the time, which is still the only isolated network in app.Networks.
+func (a *App) egressNetwork() string {
+	if a.Egress == nil {
+		return ""
+	}
+	if a.Egress.Network != "" {
+		return a.Egress.Network
+	}
+	for _, network := range a.Networks {
+		if strings.HasPrefix(network, "finks-") && strings.HasSuffix(network, "-isolated") {
+			return network
+		}
+	}
+	return egressNetworkName(a.Name)
+}
+
+// DenyEgress moves an application onto its own isolated network so it cannot open
+// outbound connections. allowTo may name containers, which are joined to the isolated
+// network, and CIDRs. Docker cannot filter by destination, so when CIDRs are given the
+// network keeps routing but disables masquerading: traffic only gets answers from
+// networks that route the container subnet back to this host.
+func (m *Manager) DenyEgress(ctx context.Context, name string, allowTo []string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+	if app.Status == StatusCreated {
+		return fmt.Errorf("application %s has not been deployed yet", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	var allowedContainers []string
+	for _, target := range allowTo {
+		if isCIDR(target) {
+			continue
+		}
+		resolved, err := m.resolveContainer(ctx, target)
+		if err != nil {
+			return err
+		}
+		allowedContainers = append(allowedContainers, resolved)
+	}
+
+	attached, err := m.dockerClient.GetContainerNetworks(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to get container networks", "app", name, "error", err)
+		return fmt.Errorf("failed to get container networks: %w", err)
+	}
+
+	// A changed policy keeps the networks saved when the app was first isolated
+	previous := app.Networks
+	var oldNetwork string
+	if app.Egress != nil {
+		previous = app.Egress.PreviousNetworks
+		oldNetwork = app.egressNetwork()
+		if err := m.removeEgressNetwork(ctx, oldNetwork); err != nil {
+			return err
+		}
+	}
+
+	// Recreate the isolated network so a changed policy takes effect
+	networkName := egressNetworkName(name)
+	app.Egress = &EgressPolicy{AllowTo: allowTo, Network: networkName, PreviousNetworks: previous}
+	if err := m.removeEgressNetwork(ctx, networkName); err != nil {
+		return err
+	}
+	if err := m.ensureEgressNetwork(ctx, app); err != nil {
+		slog.Error("failed to create isolated network", "app", name, "error", err)
+		return fmt.Errorf("failed to create isolated network: %w", err)
+	}
+
+	for _, network := range attached {
+		if network == networkName || network == oldNetwork {
+			continue
+		}
+		if err := m.dockerClient.DisconnectContainerFromNetwork(ctx, network, containerName); err != nil {
+			slog.Error("failed to disconnect container from network", "app", name, "network", network, "error", err)
+			return fmt.Errorf("failed to disconnect container from network: %w", err)
+		}
+	}
+
+	for _, container := range append([]string{containerName}, allowedContainers...) {
+		if err := m.dockerClient.ConnectContainerToNetwork(ctx, networkName, container); err != nil {
+			slog.Error("failed to connect container to network", "app", name, "container", container, "error", err)
+			return fmt.Errorf("failed to connect %s to isolated network: %w", container, err)
+		}
+	}
+
+	app.Networks = []string{networkName}
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application egress denied", "app", name, "allow_to", allowTo)
+	return nil
+}
+
+// AllowEgress removes an application's egress policy: it reconnects the container to
+// the networks it was on before it was isolated and removes the isolated network
+func (m *Manager) AllowEgress(ctx context.Context, name string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+	if app.Egress == nil {
+		return fmt.Errorf("application %s has no network policy", name)
+	}
+
+	// Policies saved before the previous networks were recorded still get the
+	// Traefik network back, since apps with a domain are deployed on it
+	containerName := fmt.Sprintf("finks-%s", name)
+	networks := app.Egress.PreviousNetworks
+	if len(networks) == 0 && app.Domain != "" {
+		networks = []string{proxy.DefaultNetworkName}
+	}
+	// Containers started without networks run on Docker's default bridge network
+	reconnect := networks
+	if len(reconnect) == 0 {
+		reconnect = []string{"bridge"}
+	}
+
+	for _, network := range reconnect {
+		if _, err := m.dockerClient.EnsureNetwork(ctx, network, "bridge", nil); err != nil {
+			slog.Error("failed to ensure network", "app", name, "network", network, "error", err)
+			return fmt.Errorf("failed to ensure network: %w", err)
+		}
+		if err := m.dockerClient.ConnectContainerToNetwork(ctx, network, containerName); err != nil {
+			slog.Error("failed to connect container to network", "app", name, "network", network, "error", err)
+			return fmt.Errorf("failed to connect container to network: %w", err)
+		}
+	}
+
+	if err := m.removeEgressNetwork(ctx, app.egressNetwork()); err != nil {
+		slog.Error("failed to remove isolated network", "app", name, "error", err)
+		return fmt.Errorf("failed to remove isolated network: %w", err)
+	}
+
+	app.Networks = networks
+	app.Egress = nil
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application egress allowed", "app", name, "networks", networks)
+	return nil
+}
+
+// ensureEgressNetwork creates the isolated network for an application's egress policy
+func (m *Manager) ensureEgressNetwork(ctx context.Context, app *App) error {
+	networkName := app.egressNetwork()
+	exists, err := m.dockerClient.NetworkExists(ctx, networkName)
+	if err != nil || exists {
+		return err
+	}
+
+	opts := docker.NetworkCreateOptions{Driver: "bridge", Internal: true}
+	for _, target := range app.Egress.AllowTo {
+		if isCIDR(target) {
+			opts.Internal = false
+			opts.Options = map[string]string{"com.docker.network.bridge.enable_ip_masquerade": "false"}
+			break
+		}
+	}
+
+	_, err = m.dockerClient.CreateNetwork(ctx, networkName, opts)
+	return err
+}
+
+// renameEgressNetwork moves the isolated network of a renamed application to the
+// name derived from newName and updates the application's networks
+func (m *Manager) renameEgressNetwork(ctx context.Context, app *App, newName string) error {
+	oldNetwork, newNetwork := app.egressNetwork(), egressNetworkName(newName)
+	if oldNetwork == newNetwork {
+		return nil
+	}
+
+	exists, err := m.dockerClient.NetworkExists(ctx, oldNetwork)
+	if err != nil {
+		return fmt.Errorf("failed to check network: %w", err)
+	}
+	if exists {
+		if _, err := m.dockerClient.RenameNetwork(ctx, oldNetwork, newNetwork); err != nil {
+			return err
+		}
+	}
+
+	app.Egress.Network = newNetwork
+	for i, network := range app.Networks {
+		if network == oldNetwork {
+			app.Networks[i] = newNetwork
+		}
+	}
+	return nil
+}
+
+// removeEgressNetwork disconnects everything from an isolated network and removes it
+func (m *Manager) removeEgressNetwork(ctx context.Context, networkName string) error {
+	exists, err := m.dockerClient.NetworkExists(ctx, networkName)
+	if err != nil {
+		return fmt.Errorf("failed to check network: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	info, err := m.dockerClient.GetNetworkInfo(ctx, networkName)
+	if err != nil {
+		return fmt.Errorf("failed to inspect network: %w", err)
+	}
+	for _, container := range info.Containers {
+		if err := m.dockerClient.DisconnectContainerFromNetwork(ctx, networkName, container.Name); err != nil {
+			return fmt.Errorf("failed to disconnect container from network: %w", err)
+		}
+	}
+
+	return m.dockerClient.RemoveNetwork(ctx, networkName)
+}
+
+// resolveContainer accepts a container name or a finks application name
+func (m *Manager) resolveContainer(ctx context.Context, target string) (string, error) {
+	for _, candidate := range []string{target, fmt.Sprintf("finks-%s", target)} {
+		exists, err := m.dockerClient.ContainerExists(ctx, candidate)
+		if err != nil {
+			return "", fmt.Errorf("failed to check if container exists: %w", err)
+		}
+		if exists {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("container %s not found", target)
+}
+
+func isCIDR(value string) bool {
+	_, _, err := net.ParseCIDR(value)
+	return err == nil
+}
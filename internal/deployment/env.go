@@ -0,0 +1,244 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+)
+
+// envHistorySize is how many replaced environments are kept per application
+const envHistorySize = 10
+
+// EnvDiff lists the environment variable keys an update added, changed or removed
+type EnvDiff struct {
+	Added   []string
+	Changed []string
+	Removed []string
+}
+
+// Empty reports whether the update changed nothing
+func (d EnvDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// UpdateAppEnv sets and unsets environment variables of an application and recreates
+// its container with the same image. The old container is only removed once the new
+// one has been created, so a failed update leaves the application as it was. Stopped
+// applications stay stopped.
+func (m *Manager) UpdateAppEnv(ctx context.Context, name string, set map[string]string, unset []string) (EnvDiff, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return EnvDiff{}, err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return EnvDiff{}, fmt.Errorf("application %s not found", name)
+	}
+
+	env, diff, err := applyEnvChanges(name, app.EnvVars, set, unset)
+	if err != nil {
+		return EnvDiff{}, err
+	}
+
+	if diff.Empty() {
+		return diff, nil
+	}
+
+	updated := *app
+	updated.EnvVars = env
+	running, err := m.replaceAppContainer(ctx, &updated)
+	if err != nil {
+		return EnvDiff{}, err
+	}
+
+	app.EnvHistory = append(app.EnvHistory, EnvSnapshot{Env: maps.Clone(app.EnvVars), ReplacedAt: time.Now()})
+	if len(app.EnvHistory) > envHistorySize {
+		app.EnvHistory = slices.Clone(app.EnvHistory[len(app.EnvHistory)-envHistorySize:])
+	}
+	app.EnvVars = env
+	if running {
+		app.Status = StatusRunning
+	}
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return EnvDiff{}, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application environment updated", "app", name,
+		"added", diff.Added, "changed", diff.Changed, "removed", diff.Removed)
+	return diff, nil
+}
+
+// RollbackAppEnv restores the environment an application had before its to-th most
+// recent update (1 is the latest) and recreates its container. The restored snapshot
+// and the more recent ones are dropped from the history.
+func (m *Manager) RollbackAppEnv(ctx context.Context, name string, to int) (EnvDiff, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return EnvDiff{}, err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return EnvDiff{}, fmt.Errorf("application %s not found", name)
+	}
+	if len(app.EnvHistory) == 0 {
+		return EnvDiff{}, fmt.Errorf("application %s has no environment history", name)
+	}
+	if to < 1 || to > len(app.EnvHistory) {
+		return EnvDiff{}, fmt.Errorf("snapshot %d does not exist; %s has %d", to, name, len(app.EnvHistory))
+	}
+
+	index := len(app.EnvHistory) - to
+	snapshot := app.EnvHistory[index]
+
+	var unset []string
+	for key := range app.EnvVars {
+		if _, ok := snapshot.Env[key]; !ok {
+			unset = append(unset, key)
+		}
+	}
+	env, diff, err := applyEnvChanges(name, app.EnvVars, snapshot.Env, unset)
+	if err != nil {
+		return EnvDiff{}, err
+	}
+
+	if !diff.Empty() {
+		updated := *app
+		updated.EnvVars = env
+		running, err := m.replaceAppContainer(ctx, &updated)
+		if err != nil {
+			return EnvDiff{}, err
+		}
+		if running {
+			app.Status = StatusRunning
+		}
+	}
+
+	app.EnvVars = env
+	app.EnvHistory = app.EnvHistory[:index]
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return EnvDiff{}, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application environment rolled back", "app", name, "snapshot", to,
+		"added", diff.Added, "changed", diff.Changed, "removed", diff.Removed)
+	return diff, nil
+}
+
+// PreviewAppEnv reports what UpdateAppEnv would change without applying it
+func (m *Manager) PreviewAppEnv(name string, set map[string]string, unset []string) (EnvDiff, error) {
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return EnvDiff{}, fmt.Errorf("application %s not found", name)
+	}
+
+	_, diff, err := applyEnvChanges(name, app.EnvVars, set, unset)
+	return diff, err
+}
+
+// applyEnvChanges returns a copy of current with set and unset applied, and the keys changed
+func applyEnvChanges(name string, current, set map[string]string, unset []string) (map[string]string, EnvDiff, error) {
+	env := maps.Clone(current)
+	if env == nil {
+		env = make(map[string]string)
+	}
+
+	var diff EnvDiff
+	for _, key := range unset {
+		if _, ok := env[key]; !ok {
+			return nil, EnvDiff{}, fmt.Errorf("environment variable %s is not set on %s", key, name)
+		}
+		delete(env, key)
+		diff.Removed = append(diff.Removed, key)
+	}
+	for _, key := range slices.Sorted(maps.Keys(set)) {
+		old, ok := env[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, key)
+		case old != set[key]:
+			diff.Changed = append(diff.Changed, key)
+		default:
+			continue
+		}
+		env[key] = set[key]
+	}
+	slices.Sort(diff.Removed)
+	return env, diff, nil
+}
+
+// replaceAppContainer recreates an application's container from app with the image
+// that is already present locally. The replacement is created as finks-<name>-next
+// before the old container is removed, and only started if the old one was running,
+// which it reports. Apps that publish a host port cannot run twice, so their old
+// container is stopped first and restarted if the replacement fails. Apps that have
+// not been deployed yet have no container; they get one on their first start.
+func (m *Manager) replaceAppContainer(ctx context.Context, app *App) (bool, error) {
+	if app.Status == StatusCreated {
+		return false, nil
+	}
+
+	containerName := fmt.Sprintf("finks-%s", app.Name)
+	nextName := containerName + "-next"
+
+	if leftover, err := m.dockerClient.ContainerExists(ctx, nextName); err != nil {
+		slog.Error("failed to check if container exists", "app", app.Name, "error", err)
+		return false, fmt.Errorf("failed to check if container exists: %w", err)
+	} else if leftover {
+		if err := m.dockerClient.RemoveContainer(ctx, nextName, true); err != nil {
+			slog.Error("failed to remove container", "app", app.Name, "error", err)
+			return false, fmt.Errorf("failed to remove leftover container: %w", err)
+		}
+	}
+
+	oldExists, err := m.dockerClient.ContainerExists(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to check if container exists", "app", app.Name, "error", err)
+		return false, fmt.Errorf("failed to check if container exists: %w", err)
+	}
+
+	running := app.Status == StatusRunning
+	stoppedOld := false
+	if oldExists {
+		detail, err := m.dockerClient.ContainerInspect(ctx, containerName)
+		if err != nil {
+			slog.Error("failed to inspect container", "app", app.Name, "error", err)
+			return false, fmt.Errorf("failed to inspect container: %w", err)
+		}
+		running = detail.Status == StatusRunning
+		if running && app.Port != "" {
+			if err := m.dockerClient.StopContainer(ctx, containerName); err != nil {
+				slog.Error("failed to stop container", "app", app.Name, "error", err)
+				return false, fmt.Errorf("failed to stop container: %w", err)
+			}
+			stoppedOld = true
+		}
+	}
+
+	if err := m.createAppContainer(ctx, app, nextName, running); err != nil {
+		m.dockerClient.RemoveContainer(ctx, nextName, true)
+		if stoppedOld {
+			m.dockerClient.StartContainer(ctx, containerName)
+		}
+		return false, err
+	}
+
+	if oldExists {
+		if err := m.dockerClient.RemoveContainer(ctx, containerName, true); err != nil {
+			slog.Error("failed to remove container", "app", app.Name, "error", err)
+			return false, fmt.Errorf("failed to remove old container: %w", err)
+		}
+	}
+
+	if err := m.dockerClient.ContainerRename(ctx, nextName, containerName); err != nil {
+		slog.Error("failed to rename container", "app", app.Name, "error", err)
+		return false, fmt.Errorf("failed to rename new container: %w", err)
+	}
+	return running, nil
+}
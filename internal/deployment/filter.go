@@ -0,0 +1,60 @@
+package deployment
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// AppFilter selects the applications ListApps returns. Empty fields match every app.
+type AppFilter struct {
+	// Labels must all be set on the app's container with the same values
+	Labels map[string]string
+	// ImagePattern is a filepath.Match glob, e.g. postgres*, or a substring
+	// of the image when it contains no glob characters
+	ImagePattern string
+	Status       string
+	// ChangedSince and ChangedBefore bound when the app was last created or updated
+	ChangedSince  time.Time
+	ChangedBefore time.Time
+}
+
+// Match reports whether app passes the filter
+func (f AppFilter) Match(app *App) bool {
+	for key, value := range f.Labels {
+		if actual, ok := app.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	if f.ImagePattern != "" {
+		if strings.ContainsAny(f.ImagePattern, "*?[") {
+			if matched, _ := filepath.Match(f.ImagePattern, app.Image); !matched {
+				return false
+			}
+		} else if !strings.Contains(app.Image, f.ImagePattern) {
+			return false
+		}
+	}
+
+	changed := app.CreatedAt
+	if app.UpdatedAt.After(changed) {
+		changed = app.UpdatedAt
+	}
+	if !f.ChangedSince.IsZero() && changed.Before(f.ChangedSince) {
+		return false
+	}
+	if !f.ChangedBefore.IsZero() && !changed.Before(f.ChangedBefore) {
+		return false
+	}
+
+	return f.Status == "" || app.Status == f.Status
+}
+
+func (f AppFilter) validate() error {
+	if _, err := filepath.Match(f.ImagePattern, ""); err != nil {
+		return fmt.Errorf("invalid image pattern %q: %w", f.ImagePattern, err)
+	}
+	return nil
+}
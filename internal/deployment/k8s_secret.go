@@ -0,0 +1,61 @@
+package deployment
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// k8sSecret holds the fields of a Kubernetes Secret manifest that carry values
+type k8sSecret struct {
+	Kind       string            `yaml:"kind"`
+	Data       map[string]string `yaml:"data"`
+	StringData map[string]string `yaml:"stringData"`
+}
+
+// ParseK8sSecretFile reads environment variables from a Kubernetes Secret manifest.
+// Values under data are base64-decoded and values under stringData are taken as is,
+// overriding data like Kubernetes does. A file without a kind is read as a plain
+// mapping of keys to base64-encoded values.
+func ParseK8sSecretFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret file: %w", err)
+	}
+
+	var secret k8sSecret
+	if err := yaml.Unmarshal(content, &secret); err != nil {
+		return nil, fmt.Errorf("failed to parse secret file: %w", err)
+	}
+
+	data, stringData := secret.Data, secret.StringData
+	switch secret.Kind {
+	case "Secret":
+	case "":
+		if err := yaml.Unmarshal(content, &data); err != nil {
+			return nil, fmt.Errorf("failed to parse secret file: expected a Secret manifest or KEY: base64value pairs: %w", err)
+		}
+		stringData = nil
+	default:
+		return nil, fmt.Errorf("%s is a %s, not a Secret", path, secret.Kind)
+	}
+
+	env := make(map[string]string, len(data)+len(stringData))
+	for key, encoded := range data {
+		value, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the value of %s: %w", key, err)
+		}
+		env[key] = string(value)
+	}
+	for key, value := range stringData {
+		env[key] = value
+	}
+	if len(env) == 0 {
+		return nil, fmt.Errorf("secret file %s has no values", path)
+	}
+	return env, nil
+}
@@ -0,0 +1,115 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+)
+
+// traefikLabelPrefix marks the container labels owned by finks' Traefik integration
+const traefikLabelPrefix = "traefik."
+
+// traefikNetworkLabel tells Traefik which network to reach a container on
+const traefikNetworkLabel = "traefik.docker.network"
+
+// generateLabels builds the container labels for an application from its stored config.
+// Generated Traefik labels take precedence over the app's extra labels.
+func generateLabels(app *App) map[string]string {
+	if app.Domain == "" && !app.SecurityHeaders && len(app.ExtraLabels) == 0 {
+		return nil
+	}
+
+	labels := map[string]string{}
+	for key, value := range app.ExtraLabels {
+		labels[key] = value
+	}
+	if app.Domain != "" {
+		for key, value := range proxy.GenerateTraefikLabels(proxy.TraefikConfig{
+			AppName:   app.Name,
+			Domain:    app.Domain,
+			Port:      containerPort(app.Port),
+			LocalMode: app.LocalMode,
+		}) {
+			labels[key] = value
+		}
+	}
+
+	if app.SecurityHeaders {
+		middleware := proxy.SecurityHeadersMiddleware(app.Name)
+		proxy.AddSecurityHeadersLabels(labels, middleware, proxy.DefaultHSTSMaxAge)
+		proxy.AddRouterMiddleware(labels, app.Name, middleware)
+	}
+
+	return labels
+}
+
+// UpdateAppLabels regenerates an application's Traefik labels and compares them with
+// its container. Unless dryRun is set, a container with stale labels is recreated.
+// It returns the label differences found.
+func (m *Manager) UpdateAppLabels(ctx context.Context, name string, dryRun bool) ([]FieldDiff, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	detail, err := m.dockerClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to inspect container", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	desired := generateLabels(app)
+	diffs := diffLabels(detail.Labels, desired)
+	if len(diffs) == 0 || dryRun {
+		return diffs, nil
+	}
+
+	changes := make(map[string]string, len(diffs))
+	for _, diff := range diffs {
+		changes[diff.Field] = diff.Desired
+	}
+
+	if err := m.dockerClient.RecreateContainerWithLabels(ctx, containerName, changes); err != nil {
+		slog.Error("failed to recreate container", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to recreate container: %w", err)
+	}
+
+	app.Labels = desired
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application labels updated", "app", name, "changes", len(diffs))
+	return diffs, nil
+}
+
+// diffLabels compares Traefik labels only, since containers also carry labels from their image.
+// A label that should be removed has an empty Desired value.
+func diffLabels(current, desired map[string]string) []FieldDiff {
+	var diffs []FieldDiff
+	for key, value := range desired {
+		if current[key] != value {
+			diffs = append(diffs, FieldDiff{Field: key, Current: current[key], Desired: value})
+		}
+	}
+	for key, value := range current {
+		if _, ok := desired[key]; !ok && strings.HasPrefix(key, traefikLabelPrefix) {
+			diffs = append(diffs, FieldDiff{Field: key, Current: value})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Field < diffs[j].Field })
+	return diffs
+}
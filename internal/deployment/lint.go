@@ -0,0 +1,132 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Severities of lint findings, from most to least severe
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+	LintInfo    = "info"
+)
+
+// secretKeyMarkers are substrings of environment variable names that usually hold secrets
+var secretKeyMarkers = []string{"PASSWORD", "SECRET", "TOKEN"}
+
+// LintFinding is a configuration issue found in a deployed application
+type LintFinding struct {
+	Severity string
+	Check    string
+	Message  string
+	// Fix suggests how to resolve the finding
+	Fix string
+}
+
+// LintApp checks a deployed application's container for common configuration
+// mistakes. Findings are ordered by severity.
+func (m *Manager) LintApp(ctx context.Context, name string) ([]LintFinding, error) {
+	app, detail, err := m.InspectApp(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if detail == nil {
+		return nil, fmt.Errorf("application %s has no container to check", name)
+	}
+
+	var findings []LintFinding
+
+	if detail.Privileged {
+		findings = append(findings, LintFinding{
+			Severity: LintError,
+			Check:    "privileged",
+			Message:  "container runs in privileged mode with full access to the host",
+			Fix:      "redeploy without privileged mode and grant only the capabilities it needs",
+		})
+	}
+
+	if detail.RestartPolicy == "" || detail.RestartPolicy == "no" {
+		findings = append(findings, LintFinding{
+			Severity: LintWarning,
+			Check:    "restart-policy",
+			Message:  "no restart policy; the container stays down after a crash or reboot",
+			Fix:      fmt.Sprintf("run 'docker update --restart unless-stopped finks-%s'", name),
+		})
+	}
+
+	var secrets []string
+	for _, key := range slices.Sorted(maps.Keys(detail.Env)) {
+		upper := strings.ToUpper(key)
+		if detail.Env[key] != "" && slices.ContainsFunc(secretKeyMarkers, func(marker string) bool { return strings.Contains(upper, marker) }) {
+			secrets = append(secrets, key)
+		}
+	}
+	if len(secrets) > 0 {
+		findings = append(findings, LintFinding{
+			Severity: LintWarning,
+			Check:    "env-secrets",
+			Message:  fmt.Sprintf("secrets in plain environment variables, visible to anyone who can inspect the container: %s", strings.Join(secrets, ", ")),
+			Fix:      "read secrets from a mounted file instead of the environment",
+		})
+	}
+
+	if detail.MemoryLimit == 0 && detail.NanoCPUs == 0 {
+		findings = append(findings, LintFinding{
+			Severity: LintWarning,
+			Check:    "resource-limits",
+			Message:  "no memory or CPU limit; the container can starve the rest of the host",
+			Fix:      fmt.Sprintf("run 'docker update --memory 512m --cpus 1 finks-%s' with limits that suit the app", name),
+		})
+	}
+
+	if usesLatestTag(app.Image) {
+		findings = append(findings, LintFinding{
+			Severity: LintWarning,
+			Check:    "latest-tag",
+			Message:  fmt.Sprintf("image %s uses the latest tag, so redeploys can silently change versions", app.Image),
+			Fix:      "pin the image to a version tag or digest",
+		})
+	}
+
+	if !detail.HasHealthcheck {
+		findings = append(findings, LintFinding{
+			Severity: LintInfo,
+			Check:    "healthcheck",
+			Message:  "no health check; failures that keep the process alive go unnoticed",
+			Fix:      "add a HEALTHCHECK to the image",
+		})
+	}
+
+	if runsAsRoot(detail.User) {
+		findings = append(findings, LintFinding{
+			Severity: LintInfo,
+			Check:    "root-user",
+			Message:  "container runs as root",
+			Fix:      "set a non-root USER in the image",
+		})
+	}
+
+	return findings, nil
+}
+
+// usesLatestTag reports whether an image reference has no tag or the latest tag.
+// Images pinned by digest are never considered latest.
+func usesLatestTag(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	// A colon before the last slash belongs to a registry port, not a tag
+	lastSegment := image[strings.LastIndex(image, "/")+1:]
+	_, tag, hasTag := strings.Cut(lastSegment, ":")
+	return !hasTag || tag == "latest"
+}
+
+// runsAsRoot reports whether a container user (name, uid, or name:group) is root
+func runsAsRoot(user string) bool {
+	user, _, _ = strings.Cut(user, ":")
+	return user == "" || user == "root" || user == "0"
+}
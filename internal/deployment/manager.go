@@ -4,17 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/bimalpaudels/finks/internal/scheduler"
 )
 
 func NewManager() (*Manager, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
+		slog.Error("failed to get user home directory", "error", err)
 		return nil, fmt.Errorf("failed to get user home directory: %w", err)
 	}
 
@@ -22,11 +29,13 @@ func NewManager() (*Manager, error) {
 	configPath := filepath.Join(dataDir, "apps.json")
 
 	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		slog.Error("failed to create data directory", "error", err)
 		return nil, fmt.Errorf("failed to create data directory: %w", err)
 	}
 
 	dockerClient, err := docker.NewClient()
 	if err != nil {
+		slog.Error("failed to create Docker client", "error", err)
 		return nil, fmt.Errorf("failed to create Docker client: %w", err)
 	}
 
@@ -40,6 +49,7 @@ func NewManager() (*Manager, error) {
 	}
 
 	if err := manager.loadConfig(); err != nil {
+		slog.Error("failed to load config", "error", err)
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
@@ -54,60 +64,220 @@ func (m *Manager) CheckDockerAvailable(ctx context.Context) error {
 	return m.dockerClient.IsAvailable(ctx)
 }
 
-func (m *Manager) DeployApp(ctx context.Context, name, image, port string, envVars map[string]string, volumes []string) error {
+func (m *Manager) DeployApp(ctx context.Context, opts DeployOptions) error {
 	if err := m.CheckDockerAvailable(ctx); err != nil {
 		return err
 	}
 
-	containerName := fmt.Sprintf("finks-%s", name)
+	name := opts.Name
+	app := newApp(opts)
+
+	if err := m.runApp(ctx, app); err != nil {
+		return err
+	}
+
+	app.Status = StatusRunning
+	app.UpdatedAt = time.Now()
+	m.config.Apps[name] = app
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application deployed", "app", name, "image", app.Image)
+	return nil
+}
+
+// CreateApp records an application definition without pulling its image or creating a container.
+// The application is deployed on its first StartApp.
+func (m *Manager) CreateApp(opts DeployOptions) error {
+	name := opts.Name
+	if _, exists := m.config.Apps[name]; exists {
+		return fmt.Errorf("application %s already exists", name)
+	}
+
+	app := newApp(opts)
+	app.Status = StatusCreated
+	app.UpdatedAt = time.Now()
+
+	m.config.Apps[name] = app
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application created", "app", name, "image", app.Image)
+	return nil
+}
+
+func newApp(opts DeployOptions) *App {
+	app := &App{
+		Name:            opts.Name,
+		Image:           opts.Image,
+		Port:            opts.Port,
+		EnvVars:         opts.EnvVars,
+		Volumes:         opts.Volumes,
+		LogDriver:       opts.LogDriver,
+		LogOptions:      opts.LogOptions,
+		Domain:          opts.Domain,
+		LocalMode:       opts.LocalMode,
+		SecurityHeaders: opts.SecurityHeaders,
+		ExtraLabels:     opts.Labels,
+		RestartPolicy:   opts.RestartPolicy,
+		CreatedAt:       time.Now(),
+	}
+
+	if opts.Domain != "" {
+		// The app has to share a network with Traefik to be routed
+		app.Networks = []string{proxy.DefaultNetworkName}
+	}
+	for _, network := range opts.Networks {
+		if !slices.Contains(app.Networks, network) {
+			app.Networks = append(app.Networks, network)
+		}
+	}
+	app.Labels = generateLabels(app)
+
+	return app
+}
 
+// containerPort returns the container side of a host:container port mapping
+func containerPort(mapping string) string {
+	if mapping == "" {
+		return ""
+	}
+	parts := strings.Split(mapping, ":")
+	return strings.Split(parts[len(parts)-1], "/")[0]
+}
+
+// runApp pulls the application's image and creates and starts its container
+func (m *Manager) runApp(ctx context.Context, app *App) error {
+	return m.runAppContainer(ctx, app, fmt.Sprintf("finks-%s", app.Name))
+}
+
+// runAppContainer runs an application under the given container name
+func (m *Manager) runAppContainer(ctx context.Context, app *App, containerName string) error {
 	if exists, err := m.dockerClient.ContainerExists(ctx, containerName); err != nil {
+		slog.Error("failed to check if container exists", "app", app.Name, "error", err)
 		return fmt.Errorf("failed to check if container exists: %w", err)
 	} else if exists {
-		return fmt.Errorf("application %s already exists", name)
+		return fmt.Errorf("application %s already exists", app.Name)
 	}
 
-	if err := m.dockerClient.PullImage(ctx, image); err != nil {
+	if err := m.dockerClient.PullImage(ctx, app.Image); err != nil {
+		slog.Error("failed to pull image", "app", app.Name, "error", err)
 		return fmt.Errorf("failed to pull image: %w", err)
 	}
 
+	return m.createAppContainer(ctx, app, containerName, true)
+}
+
+// createAppContainer creates an application's container from its stored configuration,
+// using the image that is already present locally, and starts it if start is set
+func (m *Manager) createAppContainer(ctx context.Context, app *App, containerName string, start bool) error {
+	for _, network := range app.Networks {
+		if app.Egress != nil && network == app.egressNetwork() {
+			if err := m.ensureEgressNetwork(ctx, app); err != nil {
+				slog.Error("failed to ensure network", "app", app.Name, "network", network, "error", err)
+				return fmt.Errorf("failed to ensure network: %w", err)
+			}
+			continue
+		}
+		if _, err := m.dockerClient.EnsureNetwork(ctx, network, "bridge", nil); err != nil {
+			slog.Error("failed to ensure network", "app", app.Name, "network", network, "error", err)
+			return fmt.Errorf("failed to ensure network: %w", err)
+		}
+	}
+
 	var ports []string
-	if port != "" {
-		ports = []string{port}
+	if app.Port != "" {
+		ports = []string{app.Port}
 	}
 
 	runOpts := docker.RunOptions{
-		Name:    containerName,
-		Image:   image,
-		Ports:   ports,
-		EnvVars: envVars,
-		Volumes: volumes,
+		Name:          containerName,
+		Image:         app.Image,
+		Ports:         ports,
+		EnvVars:       app.EnvVars,
+		Volumes:       app.Volumes,
+		Labels:        app.Labels,
+		Networks:      app.Networks,
+		RestartPolicy: app.RestartPolicy,
+		LogDriver:     app.LogDriver,
+		LogOptions:    app.LogOptions,
+		CreateOnly:    !start,
 	}
 
 	if err := m.dockerClient.RunContainer(ctx, runOpts); err != nil {
+		slog.Error("failed to run container", "app", app.Name, "error", err)
 		return fmt.Errorf("failed to run container: %w", err)
 	}
 
-	app := &App{
-		Name:      name,
-		Image:     image,
-		Port:      port,
-		EnvVars:   envVars,
-		Volumes:   volumes,
-		Status:    StatusRunning,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+	return nil
+}
+
+func (m *Manager) StopApp(ctx context.Context, name string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
 	}
 
-	m.config.Apps[name] = app
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	if err := m.dockerClient.StopContainer(ctx, containerName); err != nil {
+		slog.Error("failed to stop container", "app", name, "error", err)
+		return fmt.Errorf("failed to stop container: %w", err)
+	}
+
+	app.Status = StatusStopped
+	app.UpdatedAt = time.Now()
 	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
+	slog.Info("application stopped", "app", name)
 	return nil
 }
 
-func (m *Manager) StopApp(ctx context.Context, name string) error {
+func (m *Manager) StartApp(ctx context.Context, name string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	if app.Status == StatusCreated {
+		// First start of an app defined with CreateApp: perform the full deployment
+		if err := m.runApp(ctx, app); err != nil {
+			return err
+		}
+	} else {
+		containerName := fmt.Sprintf("finks-%s", name)
+		if err := m.dockerClient.StartContainer(ctx, containerName); err != nil {
+			slog.Error("failed to start container", "app", name, "error", err)
+			return fmt.Errorf("failed to start container: %w", err)
+		}
+	}
+
+	app.Status = StatusRunning
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application started", "app", name)
+	return nil
+}
+
+func (m *Manager) PauseApp(ctx context.Context, name string) error {
 	if err := m.CheckDockerAvailable(ctx); err != nil {
 		return err
 	}
@@ -118,20 +288,23 @@ func (m *Manager) StopApp(ctx context.Context, name string) error {
 	}
 
 	containerName := fmt.Sprintf("finks-%s", name)
-	if err := m.dockerClient.StopContainer(ctx, containerName); err != nil {
-		return fmt.Errorf("failed to stop container: %w", err)
+	if err := m.dockerClient.PauseContainer(ctx, containerName); err != nil {
+		slog.Error("failed to pause container", "app", name, "error", err)
+		return fmt.Errorf("failed to pause container: %w", err)
 	}
 
-	app.Status = StatusStopped
+	app.Status = StatusPaused
 	app.UpdatedAt = time.Now()
 	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
+	slog.Info("application paused", "app", name)
 	return nil
 }
 
-func (m *Manager) StartApp(ctx context.Context, name string) error {
+func (m *Manager) UnpauseApp(ctx context.Context, name string) error {
 	if err := m.CheckDockerAvailable(ctx); err != nil {
 		return err
 	}
@@ -142,16 +315,19 @@ func (m *Manager) StartApp(ctx context.Context, name string) error {
 	}
 
 	containerName := fmt.Sprintf("finks-%s", name)
-	if err := m.dockerClient.StartContainer(ctx, containerName); err != nil {
-		return fmt.Errorf("failed to start container: %w", err)
+	if err := m.dockerClient.UnpauseContainer(ctx, containerName); err != nil {
+		slog.Error("failed to unpause container", "app", name, "error", err)
+		return fmt.Errorf("failed to unpause container: %w", err)
 	}
 
 	app.Status = StatusRunning
 	app.UpdatedAt = time.Now()
 	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
+	slog.Info("application unpaused", "app", name)
 	return nil
 }
 
@@ -160,31 +336,82 @@ func (m *Manager) RemoveApp(ctx context.Context, name string, force bool) error
 		return err
 	}
 
-	_, exists := m.config.Apps[name]
+	app, exists := m.config.Apps[name]
 	if !exists {
 		return fmt.Errorf("application %s not found", name)
 	}
 
+	// Apps that were only created, or whose container was removed manually, have no container
 	containerName := fmt.Sprintf("finks-%s", name)
-	if err := m.dockerClient.RemoveContainer(ctx, containerName, force); err != nil {
-		return fmt.Errorf("failed to remove container: %w", err)
+	containerExists, err := m.dockerClient.ContainerExists(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to check if container exists", "app", name, "error", err)
+		return fmt.Errorf("failed to check if container exists: %w", err)
+	}
+
+	if containerExists {
+		if err := m.dockerClient.RemoveContainer(ctx, containerName, force); err != nil {
+			slog.Error("failed to remove container", "app", name, "error", err)
+			return fmt.Errorf("failed to remove container: %w", err)
+		}
+	}
+
+	// The isolated network belongs to the app; allowed containers are disconnected from it
+	if app.Egress != nil {
+		if err := m.removeEgressNetwork(ctx, app.egressNetwork()); err != nil {
+			slog.Error("failed to remove isolated network", "app", name, "error", err)
+			return fmt.Errorf("failed to remove isolated network: %w", err)
+		}
 	}
 
 	delete(m.config.Apps, name)
 	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
+	// Scheduled tasks would otherwise fail against the missing container on every run
+	if removed, err := scheduler.RemoveAppTasks(name); err != nil {
+		slog.Warn("failed to remove scheduled tasks", "app", name, "error", err)
+	} else if removed > 0 {
+		slog.Info("scheduled tasks removed", "app", name, "tasks", removed)
+	}
+
+	slog.Info("application removed", "app", name)
 	return nil
 }
 
-func (m *Manager) ListApps(ctx context.Context) ([]*App, error) {
+// RemoveVolumes removes named volumes, such as the ones an application mounted
+// before it was removed
+func (m *Manager) RemoveVolumes(ctx context.Context, names []string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if err := m.dockerClient.RemoveVolume(ctx, name); err != nil {
+			slog.Error("failed to remove volume", "volume", name, "error", err)
+			return fmt.Errorf("failed to remove volume: %w", err)
+		}
+		slog.Info("volume removed", "volume", name)
+	}
+	return nil
+}
+
+// ListApps returns the applications matching filter, sorted by name, with their
+// status refreshed from Docker
+func (m *Manager) ListApps(ctx context.Context, filter AppFilter) ([]*App, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+
 	if err := m.CheckDockerAvailable(ctx); err != nil {
 		return nil, err
 	}
 
 	containers, err := m.dockerClient.ListContainers(ctx)
 	if err != nil {
+		slog.Error("failed to list containers", "error", err)
 		return nil, fmt.Errorf("failed to list containers: %w", err)
 	}
 
@@ -192,8 +419,11 @@ func (m *Manager) ListApps(ctx context.Context) ([]*App, error) {
 	for _, container := range containers {
 		if appName, found := strings.CutPrefix(container.Name, "finks-"); found {
 			status := StatusRunning
-			if strings.Contains(strings.ToLower(container.Status), "exited") {
+			lowerStatus := strings.ToLower(container.Status)
+			if strings.Contains(lowerStatus, "exited") {
 				status = StatusStopped
+			} else if strings.Contains(lowerStatus, "paused") {
+				status = StatusPaused
 			}
 			containerStatuses[appName] = status
 		}
@@ -201,22 +431,168 @@ func (m *Manager) ListApps(ctx context.Context) ([]*App, error) {
 
 	apps := make([]*App, 0, len(m.config.Apps))
 	for name, app := range m.config.Apps {
-		if status, exists := containerStatuses[name]; exists {
+		status := app.Status
+		if containerStatus, exists := containerStatuses[name]; exists {
+			status = containerStatus
+		} else if app.Status != StatusCreated {
+			status = StatusUnknown
+		}
+		if status != app.Status {
 			app.Status = status
-		} else {
-			app.Status = StatusUnknown
+			app.UpdatedAt = time.Now()
+		}
+		if filter.Match(app) {
+			apps = append(apps, app)
 		}
-		app.UpdatedAt = time.Now()
-		apps = append(apps, app)
 	}
 
 	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "error", err)
 		return nil, fmt.Errorf("failed to save config: %w", err)
 	}
 
+	SortApps(apps, SortByName, false)
 	return apps, nil
 }
 
+// Fields applications can be sorted by
+const (
+	SortByName    = "name"
+	SortByStatus  = "status"
+	SortByCreated = "created"
+	SortByUpdated = "updated"
+)
+
+// SortApps sorts applications in place by field, falling back to name order for ties
+func SortApps(apps []*App, field string, reverse bool) error {
+	var less func(a, b *App) bool
+	switch field {
+	case SortByName:
+		less = func(a, b *App) bool { return false }
+	case SortByStatus:
+		less = func(a, b *App) bool { return a.Status < b.Status }
+	case SortByCreated:
+		less = func(a, b *App) bool { return a.CreatedAt.Before(b.CreatedAt) }
+	case SortByUpdated:
+		less = func(a, b *App) bool { return a.UpdatedAt.Before(b.UpdatedAt) }
+	default:
+		return fmt.Errorf("invalid sort field %q: must be one of name, status, created, updated", field)
+	}
+
+	sort.Slice(apps, func(i, j int) bool {
+		a, b := apps[i], apps[j]
+		if reverse {
+			a, b = b, a
+		}
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return a.Name < b.Name
+	})
+	return nil
+}
+
+func (m *Manager) GetAppStats(ctx context.Context, name string) (*docker.ContainerStats, error) {
+	if _, exists := m.config.Apps[name]; !exists {
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	stats, err := m.dockerClient.GetContainerStats(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to get container stats", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to get container stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (m *Manager) StreamAppLogs(ctx context.Context, name string, opts docker.LogOptions, stdout, stderr io.Writer) error {
+	if _, exists := m.config.Apps[name]; !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	if err := m.dockerClient.StreamContainerLogs(ctx, containerName, opts, stdout, stderr); err != nil {
+		slog.Error("failed to stream container logs", "app", name, "error", err)
+		return fmt.Errorf("failed to stream container logs: %w", err)
+	}
+
+	return nil
+}
+
+// WatchAppEvents streams Docker events for an application's container
+func (m *Manager) WatchAppEvents(ctx context.Context, name, since string) (<-chan docker.ContainerEvent, error) {
+	if _, exists := m.config.Apps[name]; !exists {
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	events, err := m.dockerClient.WatchContainerEvents(ctx, containerName, since)
+	if err != nil {
+		slog.Error("failed to watch container events", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to watch container events: %w", err)
+	}
+
+	return events, nil
+}
+
+// InspectApp returns the stored application together with its live container details.
+// The container details are nil when the application has no container yet.
+func (m *Manager) InspectApp(ctx context.Context, name string) (*App, *docker.ContainerDetail, error) {
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return nil, nil, fmt.Errorf("application %s not found", name)
+	}
+
+	if app.Status == StatusCreated {
+		return app, nil, nil
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	detail, err := m.dockerClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to inspect container", "app", name, "error", err)
+		return nil, nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	return app, detail, nil
+}
+
+// GetAppHealthHistory returns the recent health check attempts of an application's container
+func (m *Manager) GetAppHealthHistory(ctx context.Context, name string) ([]docker.HealthCheckResult, error) {
+	if _, exists := m.config.Apps[name]; !exists {
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	history, err := m.dockerClient.GetContainerHealthHistory(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to get health history", "app", name, "error", err)
+		return nil, fmt.Errorf("failed to get health history: %w", err)
+	}
+
+	return history, nil
+}
+
+// GetAppIP returns the address of an application's container on the given network
+func (m *Manager) GetAppIP(ctx context.Context, name, networkName string) (string, error) {
+	if _, exists := m.config.Apps[name]; !exists {
+		return "", fmt.Errorf("application %s not found", name)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	ip, err := m.dockerClient.GetContainerIP(ctx, containerName, networkName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get IP address: %w", err)
+	}
+
+	return ip, nil
+}
+
 func (m *Manager) GetApp(name string) (*App, error) {
 	app, exists := m.config.Apps[name]
 	if !exists {
@@ -232,10 +608,12 @@ func (m *Manager) loadConfig() error {
 
 	data, err := os.ReadFile(m.configPath)
 	if err != nil {
+		slog.Error("failed to read config file", "error", err)
 		return fmt.Errorf("failed to read config file: %w", err)
 	}
 
 	if err := json.Unmarshal(data, m.config); err != nil {
+		slog.Error("failed to parse config file", "error", err)
 		return fmt.Errorf("failed to parse config file: %w", err)
 	}
 
@@ -249,10 +627,12 @@ func (m *Manager) loadConfig() error {
 func (m *Manager) saveConfig() error {
 	data, err := json.MarshalIndent(m.config, "", "  ")
 	if err != nil {
+		slog.Error("failed to marshal config", "error", err)
 		return fmt.Errorf("failed to marshal config: %w", err)
 	}
 
 	if err := os.WriteFile(m.configPath, data, 0644); err != nil {
+		slog.Error("failed to write config file", "error", err)
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
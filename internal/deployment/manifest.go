@@ -0,0 +1,147 @@
+package deployment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFile is the per-project manifest read by finks up and finks down
+const ManifestFile = "finks.yml"
+
+// Manifest describes the application a project deploys. It is kept in finks.yml
+// next to the project's code so the deployment can be version-controlled with it.
+type Manifest struct {
+	// Name of the application; finks init defaults it to the directory name
+	Name string `yaml:"name"`
+	// Image to deploy (e.g. nginx:1.27)
+	Image string `yaml:"image"`
+	// Port maps a host port to a container port (e.g. 8080:80)
+	Port string `yaml:"port,omitempty"`
+	// Env sets environment variables in the container
+	Env map[string]string `yaml:"env,omitempty"`
+	// Volumes are named volumes or bind mounts; relative host paths are resolved
+	// against the directory of finks.yml
+	Volumes []string `yaml:"volumes,omitempty"`
+	// Networks are joined in addition to the network routing requires
+	Networks []string `yaml:"networks,omitempty"`
+	// Routing serves the application through Traefik when set
+	Routing *ManifestRouting `yaml:"routing,omitempty"`
+}
+
+// ManifestRouting is the Traefik routing of a manifest's application
+type ManifestRouting struct {
+	Domain string `yaml:"domain"`
+	// Local serves the domain over plain HTTP instead of HTTPS with Let's Encrypt
+	Local bool `yaml:"local,omitempty"`
+	// SecurityHeaders adds HSTS and other security headers to the router
+	SecurityHeaders bool `yaml:"security_headers,omitempty"`
+}
+
+// manifestTemplate is written by finks init with the application name and image filled in
+const manifestTemplate = `# finks application manifest. Deploy with 'finks up', remove with 'finks down'.
+name: %s
+image: %s
+
+# Host port to container port
+port: "8080:80"
+
+# env:
+#   LOG_LEVEL: info
+
+# Named volumes or bind mounts; relative paths are resolved against this file
+# volumes:
+#   - ./data:/data
+
+# networks:
+#   - finks-default
+
+# Serve the application through Traefik
+# routing:
+#   domain: app.example.com
+#   local: false
+#   security_headers: true
+`
+
+// defaultManifestImage is the placeholder image of a new manifest
+const defaultManifestImage = "nginx:latest"
+
+// ManifestTemplate returns the contents of a new finks.yml. An empty image is
+// replaced with a placeholder.
+func ManifestTemplate(name, image string) []byte {
+	if image == "" {
+		image = defaultManifestImage
+	}
+	return fmt.Appendf(nil, manifestTemplate, name, image)
+}
+
+// LoadManifest reads and validates a finks.yml
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	manifest := &Manifest{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(manifest); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+
+	if manifest.Name == "" {
+		return nil, fmt.Errorf("manifest %s has no name", path)
+	}
+	if manifest.Image == "" {
+		return nil, fmt.Errorf("manifest %s has no image", path)
+	}
+	if manifest.Routing != nil && manifest.Routing.Domain == "" {
+		return nil, fmt.Errorf("manifest %s has routing without a domain", path)
+	}
+
+	baseDir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve manifest directory: %w", err)
+	}
+	for i, volume := range manifest.Volumes {
+		manifest.Volumes[i] = resolveBindMount(volume, baseDir)
+	}
+
+	return manifest, nil
+}
+
+// DeployOptions converts the manifest into options for DeployApp
+func (m *Manifest) DeployOptions() DeployOptions {
+	opts := DeployOptions{
+		Name:     m.Name,
+		Image:    m.Image,
+		Port:     m.Port,
+		EnvVars:  m.Env,
+		Volumes:  m.Volumes,
+		Networks: m.Networks,
+	}
+	if m.Routing != nil {
+		opts.Domain = m.Routing.Domain
+		opts.LocalMode = m.Routing.Local
+		opts.SecurityHeaders = m.Routing.SecurityHeaders
+	}
+	return opts
+}
+
+// NamedVolumes returns the Docker volumes the manifest mounts, leaving out bind mounts
+func (m *Manifest) NamedVolumes() []string {
+	var names []string
+	for _, volume := range m.Volumes {
+		source, _, found := strings.Cut(volume, ":")
+		if found && isNamedVolume(source) {
+			names = append(names, source)
+		}
+	}
+	return names
+}
@@ -0,0 +1,93 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+)
+
+// CopyMiddlewares copies the Traefik middleware labels of the src container to dst,
+// renaming them for dst. The labels are kept as extra labels of dst, and middlewares
+// used by the router of src are added to the router of dst when it has a domain.
+// Unless dryRun is set, the dst container is recreated with the new labels.
+// It returns the label differences on dst.
+func (m *Manager) CopyMiddlewares(ctx context.Context, src, dst string, opts proxy.MiddlewareCopyOptions, dryRun bool) ([]FieldDiff, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	if src == dst {
+		return nil, fmt.Errorf("source and destination must be different applications")
+	}
+	if _, exists := m.config.Apps[src]; !exists {
+		return nil, fmt.Errorf("application %s not found", src)
+	}
+	app, exists := m.config.Apps[dst]
+	if !exists {
+		return nil, fmt.Errorf("application %s not found", dst)
+	}
+
+	srcDetail, err := m.dockerClient.ContainerInspect(ctx, fmt.Sprintf("finks-%s", src))
+	if err != nil {
+		slog.Error("failed to inspect container", "app", src, "error", err)
+		return nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	copied, routed := proxy.CopyMiddlewareLabels(srcDetail.Labels, src, dst, opts)
+	if len(copied) == 0 {
+		return nil, fmt.Errorf("no matching middlewares found on %s", src)
+	}
+
+	extra := maps.Clone(app.ExtraLabels)
+	if extra == nil {
+		extra = make(map[string]string, len(copied))
+	}
+	maps.Copy(extra, copied)
+	if app.Domain != "" {
+		for _, name := range routed {
+			proxy.AddRouterMiddleware(extra, dst, name)
+		}
+	}
+
+	updated := *app
+	updated.ExtraLabels = extra
+	desired := generateLabels(&updated)
+
+	containerName := fmt.Sprintf("finks-%s", dst)
+	detail, err := m.dockerClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to inspect container", "app", dst, "error", err)
+		return nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	diffs := diffLabels(detail.Labels, desired)
+	if dryRun {
+		return diffs, nil
+	}
+
+	if len(diffs) > 0 {
+		changes := make(map[string]string, len(diffs))
+		for _, diff := range diffs {
+			changes[diff.Field] = diff.Desired
+		}
+		if err := m.dockerClient.RecreateContainerWithLabels(ctx, containerName, changes); err != nil {
+			slog.Error("failed to recreate container", "app", dst, "error", err)
+			return nil, fmt.Errorf("failed to recreate container: %w", err)
+		}
+	}
+
+	app.ExtraLabels = extra
+	app.Labels = desired
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", dst, "error", err)
+		return nil, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("middlewares copied", "from", src, "to", dst, "labels", len(copied))
+	return diffs, nil
+}
@@ -0,0 +1,110 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// MigrateOptions describes a one-off migration run before an application is updated
+type MigrateOptions struct {
+	// Image to run the migration with; defaults to the application's current image
+	Image   string
+	Command string
+	Timeout time.Duration
+}
+
+// RunMigration runs a command in a temporary container that shares the application's
+// environment, volumes and networks. Output is written to output. It returns an
+// error if the command exits non-zero or does not finish within the timeout.
+func (m *Manager) RunMigration(ctx context.Context, name string, opts MigrateOptions, output io.Writer) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	image := opts.Image
+	if image == "" {
+		image = app.Image
+	}
+
+	if err := m.dockerClient.PullImage(ctx, image); err != nil {
+		slog.Error("failed to pull image", "app", name, "error", err)
+		return fmt.Errorf("failed to pull image: %w", err)
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	task := docker.TaskOptions{
+		Image:      image,
+		Entrypoint: []string{"/bin/sh", "-c", opts.Command},
+		EnvVars:    app.EnvVars,
+		Volumes:    app.Volumes,
+		Networks:   app.Networks,
+	}
+
+	exitCode, err := m.dockerClient.RunTask(ctx, task, output, output)
+	if err != nil {
+		slog.Error("failed to run migration", "app", name, "error", err)
+		return fmt.Errorf("failed to run migration: %w", err)
+	}
+	if exitCode != 0 {
+		slog.Error("migration failed", "app", name, "exit_code", exitCode)
+		return fmt.Errorf("migration exited with code %d", exitCode)
+	}
+
+	slog.Info("migration completed", "app", name, "image", image)
+	return nil
+}
+
+// UpdateApp redeploys an application with a new image, keeping the rest of its
+// configuration. The old container is only removed once the new one has been
+// created, so a failed update leaves the application as it was. Stopped
+// applications stay stopped.
+func (m *Manager) UpdateApp(ctx context.Context, name, image string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	if err := m.dockerClient.PullImage(ctx, image); err != nil {
+		slog.Error("failed to pull image", "app", name, "error", err)
+		return fmt.Errorf("failed to pull image: %w", err)
+	}
+
+	updated := *app
+	updated.Image = image
+	running, err := m.replaceAppContainer(ctx, &updated)
+	if err != nil {
+		return err
+	}
+
+	app.Image = image
+	if running {
+		app.Status = StatusRunning
+	}
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application updated", "app", name, "image", image)
+	return nil
+}
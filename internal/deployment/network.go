@@ -0,0 +1,244 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+)
+
+// AddNetwork connects an application's container to an additional network
+func (m *Manager) AddNetwork(ctx context.Context, appName, networkName string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[appName]
+	if !exists {
+		return fmt.Errorf("application %s not found", appName)
+	}
+
+	if slices.Contains(app.Networks, networkName) {
+		return fmt.Errorf("application %s is already connected to network %s", appName, networkName)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", appName)
+	if err := m.dockerClient.ConnectContainerToNetwork(ctx, networkName, containerName); err != nil {
+		slog.Error("failed to connect container to network", "app", appName, "network", networkName, "error", err)
+		return fmt.Errorf("failed to connect container to network: %w", err)
+	}
+
+	app.Networks = append(app.Networks, networkName)
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", appName, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application connected to network", "app", appName, "network", networkName)
+	return nil
+}
+
+// RemoveNetwork disconnects an application's container from a network
+func (m *Manager) RemoveNetwork(ctx context.Context, appName, networkName string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[appName]
+	if !exists {
+		return fmt.Errorf("application %s not found", appName)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", appName)
+	if err := m.dockerClient.DisconnectContainerFromNetwork(ctx, networkName, containerName); err != nil {
+		slog.Error("failed to disconnect container from network", "app", appName, "network", networkName, "error", err)
+		return fmt.Errorf("failed to disconnect container from network: %w", err)
+	}
+
+	app.Networks = slices.DeleteFunc(app.Networks, func(n string) bool { return n == networkName })
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", appName, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application disconnected from network", "app", appName, "network", networkName)
+	return nil
+}
+
+// NetworkRenameResult reports what a network rename changed besides the network itself
+type NetworkRenameResult struct {
+	// Moved are the containers reconnected to the renamed network
+	Moved []string
+	// Relabeled are the applications whose traefik.docker.network label was updated
+	Relabeled []string
+}
+
+// RenameNetwork recreates a Docker network under a new name, moving its containers
+// and updating the applications that reference it
+func (m *Manager) RenameNetwork(ctx context.Context, oldName, newName string) (*NetworkRenameResult, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	// Traefik is created on its own network and generated labels route every app
+	// through the default one, so both names are fixed
+	if oldName == proxy.DefaultNetworkName || oldName == proxy.TraefikNetworkName {
+		return nil, fmt.Errorf("network %s is managed by finks and cannot be renamed", oldName)
+	}
+	for _, app := range m.config.Apps {
+		if app.Egress != nil && oldName == app.egressNetwork() {
+			return nil, fmt.Errorf("network %s is the isolated network of %s and cannot be renamed", oldName, app.Name)
+		}
+	}
+
+	moved, err := m.dockerClient.RenameNetwork(ctx, oldName, newName)
+	if err != nil {
+		slog.Error("failed to rename network", "network", oldName, "new_name", newName, "error", err)
+		return nil, fmt.Errorf("failed to rename network: %w", err)
+	}
+	result := &NetworkRenameResult{Moved: moved}
+
+	// Apps whose labels point Traefik at the old network would no longer be routed
+	var relabelErr error
+	for _, name := range slices.Sorted(maps.Keys(m.config.Apps)) {
+		app := m.config.Apps[name]
+		updated := false
+		if i := slices.Index(app.Networks, oldName); i >= 0 {
+			app.Networks[i] = newName
+			updated = true
+		}
+		if app.ExtraLabels[traefikNetworkLabel] == oldName || app.Labels[traefikNetworkLabel] == oldName {
+			relabeled, err := m.relabelTraefikNetwork(ctx, app, oldName, newName)
+			if err != nil {
+				slog.Error("failed to update traefik network label", "app", name, "error", err)
+				relabelErr = errors.Join(relabelErr, fmt.Errorf("failed to update labels of %s: %w", name, err))
+			}
+			if relabeled {
+				result.Relabeled = append(result.Relabeled, name)
+				updated = true
+			}
+		}
+		if updated {
+			app.UpdatedAt = time.Now()
+		}
+	}
+
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "error", err)
+		return nil, fmt.Errorf("failed to save config: %w", err)
+	}
+	if relabelErr != nil {
+		return result, fmt.Errorf("network renamed, but %w", relabelErr)
+	}
+
+	slog.Info("network renamed", "network", oldName, "new_name", newName, "containers", len(moved), "relabeled", len(result.Relabeled))
+	return result, nil
+}
+
+// relabelTraefikNetwork points an application's traefik.docker.network label at a
+// renamed network, recreating its container when it has one. It reports whether
+// the stored labels were updated.
+func (m *Manager) relabelTraefikNetwork(ctx context.Context, app *App, oldName, newName string) (bool, error) {
+	containerName := fmt.Sprintf("finks-%s", app.Name)
+	hasContainer, err := m.dockerClient.ContainerExists(ctx, containerName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check container: %w", err)
+	}
+	if hasContainer {
+		// Docker labels are immutable, so the container has to be recreated to apply them
+		changes := map[string]string{traefikNetworkLabel: newName}
+		if err := m.dockerClient.RecreateContainerWithLabels(ctx, containerName, changes); err != nil {
+			return false, fmt.Errorf("failed to recreate container: %w", err)
+		}
+	}
+
+	if app.ExtraLabels[traefikNetworkLabel] == oldName {
+		app.ExtraLabels[traefikNetworkLabel] = newName
+	}
+	if app.Labels[traefikNetworkLabel] == oldName {
+		app.Labels[traefikNetworkLabel] = newName
+	}
+	return true, nil
+}
+
+// ProxyConnectResult lists the applications ConnectAppsToProxy looked at, by outcome
+type ProxyConnectResult struct {
+	AlreadyConnected []string
+	Connected        []string
+	// MissingLabels are applications without Traefik labels, which Traefik does not route to
+	MissingLabels []string
+	// Failed maps the applications that could not be connected to the reason
+	Failed map[string]error
+}
+
+// ConnectAppsToProxy connects every deployed application with Traefik labels to the
+// network its labels tell Traefik to reach it on. Applications without labels are
+// only reported. Connected applications are left alone, so it is safe to run repeatedly.
+// An application that cannot be connected is recorded in Failed and the rest still run.
+func (m *Manager) ConnectAppsToProxy(ctx context.Context) (*ProxyConnectResult, error) {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return nil, err
+	}
+
+	result := &ProxyConnectResult{Failed: make(map[string]error)}
+	for _, name := range slices.Sorted(maps.Keys(m.config.Apps)) {
+		app := m.config.Apps[name]
+		if app.Status == StatusCreated {
+			continue
+		}
+
+		containerName := fmt.Sprintf("finks-%s", name)
+		detail, err := m.dockerClient.ContainerInspect(ctx, containerName)
+		if err != nil {
+			slog.Error("failed to inspect container", "app", name, "error", err)
+			result.Failed[name] = fmt.Errorf("failed to inspect container: %w", err)
+			continue
+		}
+
+		if detail.Labels["traefik.enable"] != "true" {
+			result.MissingLabels = append(result.MissingLabels, name)
+			continue
+		}
+
+		networkName := detail.Labels[traefikNetworkLabel]
+		if networkName == "" {
+			networkName = proxy.DefaultNetworkName
+		}
+		if _, ok := detail.Networks[networkName]; ok {
+			result.AlreadyConnected = append(result.AlreadyConnected, name)
+			continue
+		}
+
+		if _, err := m.dockerClient.EnsureNetwork(ctx, networkName, "bridge", nil); err != nil {
+			slog.Error("failed to ensure network", "app", name, "network", networkName, "error", err)
+			result.Failed[name] = fmt.Errorf("failed to ensure network: %w", err)
+			continue
+		}
+		if err := m.dockerClient.ConnectContainerToNetwork(ctx, networkName, containerName); err != nil {
+			slog.Error("failed to connect container to network", "app", name, "network", networkName, "error", err)
+			result.Failed[name] = fmt.Errorf("failed to connect container to network: %w", err)
+			continue
+		}
+
+		if !slices.Contains(app.Networks, networkName) {
+			app.Networks = append(app.Networks, networkName)
+		}
+		app.UpdatedAt = time.Now()
+		if err := m.saveConfig(); err != nil {
+			slog.Error("failed to save config", "app", name, "error", err)
+			return result, fmt.Errorf("failed to save config: %w", err)
+		}
+
+		result.Connected = append(result.Connected, name)
+		slog.Info("application connected to proxy network", "app", name, "network", networkName)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// RecreateApp replaces an application's container with a fresh one built from its
+// stored configuration, so the container starts from a clean filesystem. Named volumes
+// are kept and anonymous volumes are removed. With pull, the image is pulled first, so
+// a failed pull leaves the old container running. timeout bounds the stop step. The
+// new container is only started if the application was running.
+func (m *Manager) RecreateApp(ctx context.Context, name string, pull bool, timeout time.Duration) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+	if app.Status == StatusCreated {
+		return fmt.Errorf("application %s has no container yet; start it with 'finks app start %s'", name, name)
+	}
+
+	if pull {
+		if err := m.dockerClient.PullImage(ctx, app.Image); err != nil {
+			slog.Error("failed to pull image", "app", name, "error", err)
+			return fmt.Errorf("failed to pull image: %w", err)
+		}
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	containerExists, err := m.dockerClient.ContainerExists(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to check if container exists", "app", name, "error", err)
+		return fmt.Errorf("failed to check if container exists: %w", err)
+	}
+
+	if containerExists {
+		if err := m.dockerClient.StopContainerWithTimeout(ctx, containerName, timeout); err != nil {
+			slog.Error("failed to stop container", "app", name, "error", err)
+			return fmt.Errorf("failed to stop container: %w", err)
+		}
+		if err := m.dockerClient.RemoveContainerAndVolumes(ctx, containerName); err != nil {
+			slog.Error("failed to remove container", "app", name, "error", err)
+			return fmt.Errorf("failed to remove container: %w", err)
+		}
+	}
+
+	if err := m.createAppContainer(ctx, app, containerName, app.Status == StatusRunning); err != nil {
+		return err
+	}
+
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application recreated", "app", name, "pulled", pull)
+	return nil
+}
@@ -0,0 +1,139 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/proxy"
+	"github.com/bimalpaudels/finks/internal/scheduler"
+)
+
+// RenameApp renames an application, its container and its Traefik routing
+func (m *Manager) RenameApp(ctx context.Context, oldName, newName string) error {
+	return m.RenameAppWithProgress(ctx, oldName, newName, nil)
+}
+
+// RenameAppWithProgress renames an application and calls progress before each step
+func (m *Manager) RenameAppWithProgress(ctx context.Context, oldName, newName string, progress func(step string)) error {
+	if progress == nil {
+		progress = func(string) {}
+	}
+
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[oldName]
+	if !exists {
+		return fmt.Errorf("application %s not found", oldName)
+	}
+	if _, taken := m.config.Apps[newName]; taken {
+		return fmt.Errorf("application %s already exists", newName)
+	}
+
+	oldContainer := fmt.Sprintf("finks-%s", oldName)
+	newContainer := fmt.Sprintf("finks-%s", newName)
+
+	if exists, err := m.dockerClient.ContainerExists(ctx, newContainer); err != nil {
+		slog.Error("failed to check if container exists", "app", newName, "error", err)
+		return fmt.Errorf("failed to check if container exists: %w", err)
+	} else if exists {
+		return fmt.Errorf("container %s already exists", newContainer)
+	}
+
+	hasContainer, err := m.dockerClient.ContainerExists(ctx, oldContainer)
+	if err != nil {
+		slog.Error("failed to check if container exists", "app", oldName, "error", err)
+		return fmt.Errorf("failed to check if container exists: %w", err)
+	}
+
+	var labels map[string]string
+	if hasContainer {
+		if labels, err = m.dockerClient.GetContainerLabels(ctx, oldContainer); err != nil {
+			slog.Error("failed to get container labels", "app", oldName, "error", err)
+			return fmt.Errorf("failed to get container labels: %w", err)
+		}
+
+		progress(fmt.Sprintf("Renaming container %s to %s", oldContainer, newContainer))
+		if err := m.dockerClient.ContainerRename(ctx, oldContainer, newContainer); err != nil {
+			slog.Error("failed to rename container", "app", oldName, "error", err)
+			return fmt.Errorf("failed to rename container: %w", err)
+		}
+	}
+
+	if app.Egress != nil {
+		progress(fmt.Sprintf("Renaming isolated network to %s", egressNetworkName(newName)))
+		if err := m.renameEgressNetwork(ctx, app, newName); err != nil {
+			slog.Error("failed to rename isolated network", "app", oldName, "error", err)
+			return fmt.Errorf("failed to rename isolated network: %w", err)
+		}
+	}
+
+	// Persist the new name right away so the config matches the renamed container
+	progress("Updating application config")
+	delete(m.config.Apps, oldName)
+	app.Name = newName
+	app.UpdatedAt = time.Now()
+	m.config.Apps[newName] = app
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", newName, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	if moved, err := scheduler.RenameAppTasks(oldName, newName); err != nil {
+		slog.Warn("failed to rename scheduled tasks", "app", newName, "error", err)
+	} else if moved > 0 {
+		slog.Info("scheduled tasks renamed", "app", newName, "tasks", moved)
+	}
+
+	// Apps deployed with a domain regenerate their labels from config; older apps
+	// have them recovered from the container
+	newLabels, routed := generateLabels(app), true
+	if newLabels == nil {
+		newLabels, routed = proxy.RenameTraefikLabels(labels, oldName, newName)
+	}
+	if !routed {
+		slog.Info("application renamed", "app", newName, "previous", oldName)
+		return nil
+	}
+
+	progress("Regenerating Traefik labels")
+	app.Labels = newLabels
+	if !hasContainer {
+		// The labels are applied when the container is first created
+		if err := m.saveConfig(); err != nil {
+			slog.Error("failed to save config", "app", newName, "error", err)
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		slog.Info("application renamed", "app", newName, "previous", oldName)
+		return nil
+	}
+
+	// Clear the routers and services registered under the old name
+	changes := maps.Clone(newLabels)
+	for key := range labels {
+		if _, keep := newLabels[key]; !keep && strings.HasPrefix(key, "traefik.") {
+			changes[key] = ""
+		}
+	}
+
+	// Docker labels are immutable, so the container has to be recreated to apply them
+	progress(fmt.Sprintf("Recreating container %s with the new labels", newContainer))
+	if err := m.dockerClient.RecreateContainerWithLabels(ctx, newContainer, changes); err != nil {
+		slog.Error("failed to recreate container", "app", newName, "error", err)
+		return fmt.Errorf("failed to recreate container: %w", err)
+	}
+
+	progress("Saving configuration")
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", newName, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application renamed", "app", newName, "previous", oldName)
+	return nil
+}
@@ -0,0 +1,92 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// rollingHealthTimeout bounds how long a rolling update waits for the new container
+const rollingHealthTimeout = 60 * time.Second
+
+// RollingUpdate replaces an application's container with one running image without
+// downtime. The new container is started next to the old one as finks-<name>-new and
+// only takes over once its health check passes; until then Traefik routes to both.
+// If the new container does not become healthy, it is removed and the old one keeps running.
+func (m *Manager) RollingUpdate(ctx context.Context, name, image string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	app, exists := m.config.Apps[name]
+	if !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+	if app.Port != "" {
+		// Two containers cannot bind the same host port
+		return fmt.Errorf("application %s publishes host port %s, which rules out a rolling update; route it through Traefik with --domain instead", name, app.Port)
+	}
+
+	containerName := fmt.Sprintf("finks-%s", name)
+	newContainerName := containerName + "-new"
+
+	// A leftover container from an interrupted update would block the new one
+	if leftover, err := m.dockerClient.ContainerExists(ctx, newContainerName); err != nil {
+		slog.Error("failed to check if container exists", "app", name, "error", err)
+		return fmt.Errorf("failed to check if container exists: %w", err)
+	} else if leftover {
+		if err := m.dockerClient.RemoveContainer(ctx, newContainerName, true); err != nil {
+			slog.Error("failed to remove container", "app", name, "error", err)
+			return fmt.Errorf("failed to remove leftover container: %w", err)
+		}
+	}
+
+	updated := *app
+	updated.Image = image
+	if err := m.runAppContainer(ctx, &updated, newContainerName); err != nil {
+		m.dockerClient.RemoveContainer(ctx, newContainerName, true)
+		return err
+	}
+
+	healthCtx, cancel := context.WithTimeout(ctx, rollingHealthTimeout)
+	err := m.dockerClient.WaitHealthy(healthCtx, newContainerName)
+	cancel()
+	if err != nil {
+		slog.Error("new container did not become healthy", "app", name, "image", image, "error", err)
+		m.dockerClient.RemoveContainer(ctx, newContainerName, true)
+		return fmt.Errorf("new container did not become healthy, keeping the current one: %w", err)
+	}
+
+	oldExists, err := m.dockerClient.ContainerExists(ctx, containerName)
+	if err != nil {
+		slog.Error("failed to check if container exists", "app", name, "error", err)
+		return fmt.Errorf("failed to check if container exists: %w", err)
+	}
+	if oldExists {
+		if err := m.dockerClient.StopContainer(ctx, containerName); err != nil {
+			slog.Error("failed to stop container", "app", name, "error", err)
+			return fmt.Errorf("failed to stop old container: %w", err)
+		}
+		if err := m.dockerClient.RemoveContainer(ctx, containerName, true); err != nil {
+			slog.Error("failed to remove container", "app", name, "error", err)
+			return fmt.Errorf("failed to remove old container: %w", err)
+		}
+	}
+
+	if err := m.dockerClient.ContainerRename(ctx, newContainerName, containerName); err != nil {
+		slog.Error("failed to rename container", "app", name, "error", err)
+		return fmt.Errorf("failed to rename new container: %w", err)
+	}
+
+	app.Image = image
+	app.Status = StatusRunning
+	app.UpdatedAt = time.Now()
+	if err := m.saveConfig(); err != nil {
+		slog.Error("failed to save config", "app", name, "error", err)
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	slog.Info("application updated", "app", name, "image", image, "rolling", true)
+	return nil
+}
@@ -7,14 +7,65 @@ import (
 )
 
 type App struct {
-	Name      string            `json:"name"`
-	Image     string            `json:"image"`
-	Port      string            `json:"port,omitempty"`
-	EnvVars   map[string]string `json:"env_vars,omitempty"`
-	Volumes   []string          `json:"volumes,omitempty"`
-	Status    string            `json:"status"`
-	CreatedAt time.Time         `json:"created_at"`
-	UpdatedAt time.Time         `json:"updated_at"`
+	Name    string            `json:"name"`
+	Image   string            `json:"image"`
+	Port    string            `json:"port,omitempty"`
+	EnvVars map[string]string `json:"env_vars,omitempty"`
+	// EnvHistory holds the environments replaced by updates, oldest first
+	EnvHistory       []EnvSnapshot     `json:"env_history,omitempty"`
+	Volumes          []string          `json:"volumes,omitempty"`
+	Networks         []string          `json:"networks,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	ExtraLabels      map[string]string `json:"extra_labels,omitempty"`
+	RestartPolicy    string            `json:"restart_policy,omitempty"`
+	AutoRestartOnOOM bool              `json:"auto_restart_on_oom,omitempty"`
+	Egress           *EgressPolicy     `json:"egress,omitempty"`
+	Domain           string            `json:"domain,omitempty"`
+	LocalMode        bool              `json:"local_mode,omitempty"`
+	SecurityHeaders  bool              `json:"security_headers,omitempty"`
+	LogDriver        string            `json:"log_driver,omitempty"`
+	LogOptions       map[string]string `json:"log_options,omitempty"`
+	Status           string            `json:"status"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+}
+
+// EnvSnapshot is an application environment as it was before an update
+type EnvSnapshot struct {
+	Env        map[string]string `json:"env"`
+	ReplacedAt time.Time         `json:"replaced_at"`
+}
+
+// DeployOptions describes an application to deploy or create
+type DeployOptions struct {
+	Name       string
+	Image      string
+	Port       string
+	EnvVars    map[string]string
+	Volumes    []string
+	LogDriver  string
+	LogOptions map[string]string
+	// Domain routes the app through Traefik; LocalMode serves it over plain HTTP
+	Domain    string
+	LocalMode bool
+	// SecurityHeaders adds HSTS and other security headers to the app's router
+	SecurityHeaders bool
+	// Labels are applied to the container alongside the labels finks generates
+	Labels map[string]string
+	// Networks are joined in addition to the network Domain requires
+	Networks      []string
+	RestartPolicy string
+}
+
+// EgressPolicy confines an application to an isolated network
+type EgressPolicy struct {
+	// AllowTo lists containers joined to the isolated network and CIDRs that stay routable
+	AllowTo []string `json:"allow_to,omitempty"`
+	// Network is the isolated network, finks-<app>-isolated when the policy was applied
+	Network string `json:"network,omitempty"`
+	// PreviousNetworks are the networks the application was on before it was
+	// isolated, restored by AllowEgress
+	PreviousNetworks []string `json:"previous_networks,omitempty"`
 }
 
 type Config struct {
@@ -29,8 +80,10 @@ type Manager struct {
 }
 
 const (
+	StatusCreated = "created"
 	StatusRunning = "running"
 	StatusStopped = "stopped"
+	StatusPaused  = "paused"
 	StatusFailed  = "failed"
 	StatusUnknown = "unknown"
 )
@@ -0,0 +1,27 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// PushAndUpdateApp pushes a locally built image to its registry and redeploys the
+// application with it. finks pulls images on every deploy, so a local image has
+// to round-trip through a registry.
+func (m *Manager) PushAndUpdateApp(ctx context.Context, name, image string) error {
+	if err := m.CheckDockerAvailable(ctx); err != nil {
+		return err
+	}
+
+	if _, exists := m.config.Apps[name]; !exists {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	if err := m.dockerClient.PushImage(ctx, image); err != nil {
+		slog.Error("failed to push image", "app", name, "image", image, "error", err)
+		return fmt.Errorf("failed to push image: %w", err)
+	}
+
+	return m.UpdateApp(ctx, name, image)
+}
@@ -0,0 +1,71 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// archiveImage is the helper image used to tar up volume contents
+const archiveImage = "alpine:3"
+
+// ArchiveVolumes writes a gzipped tar of the given volumes to w. mounts maps a
+// volume source (a named volume or host path) to the directory name it gets
+// inside the archive. The volumes are mounted read-only into a throwaway
+// helper container, so this works for named volumes as well as bind mounts.
+func (c *Client) ArchiveVolumes(ctx context.Context, mounts map[string]string, w io.Writer) error {
+	if err := c.PullImage(ctx, archiveImage); err != nil {
+		return err
+	}
+
+	binds := make([]string, 0, len(mounts))
+	for source, name := range mounts {
+		binds = append(binds, fmt.Sprintf("%s:/backup/%s:ro", source, name))
+	}
+
+	resp, err := c.cli.ContainerCreate(ctx,
+		&container.Config{
+			Image:        archiveImage,
+			Cmd:          []string{"tar", "czf", "-", "-C", "/backup", "."},
+			AttachStdout: true,
+			AttachStderr: true,
+		},
+		&container.HostConfig{Binds: binds},
+		nil, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to create backup container: %w", err)
+	}
+	defer c.cli.ContainerRemove(context.Background(), resp.ID, container.RemoveOptions{Force: true})
+
+	attach, err := c.cli.ContainerAttach(ctx, resp.ID, container.AttachOptions{Stream: true, Stdout: true, Stderr: true})
+	if err != nil {
+		return fmt.Errorf("failed to attach to backup container: %w", err)
+	}
+	defer attach.Close()
+
+	if err := c.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		return fmt.Errorf("failed to start backup container: %w", err)
+	}
+
+	var stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(w, &stderr, attach.Reader); err != nil {
+		return fmt.Errorf("failed to read backup archive: %w", err)
+	}
+
+	waitCh, errCh := c.cli.ContainerWait(ctx, resp.ID, container.WaitConditionNotRunning)
+	select {
+	case result := <-waitCh:
+		if result.StatusCode != 0 {
+			return fmt.Errorf("backup container exited with code %d: %s", result.StatusCode, strings.TrimSpace(stderr.String()))
+		}
+	case err := <-errCh:
+		return fmt.Errorf("failed to wait for backup container: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/docker/api/types/build"
+	"github.com/docker/docker/pkg/jsonmessage"
+)
+
+// BuildOptions describes an image build
+type BuildOptions struct {
+	// ContextDir is the build context sent to the daemon. .dockerignore is not applied.
+	ContextDir string
+	// Dockerfile is relative to ContextDir; defaults to Dockerfile
+	Dockerfile string
+	Tag        string
+	BuildArgs  map[string]string
+}
+
+// BuildImage builds an image from a local context directory, streams the build
+// output to out and returns the ID of the built image
+func (c *Client) BuildImage(ctx context.Context, opts BuildOptions, out io.Writer) (string, error) {
+	dockerfile := opts.Dockerfile
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	if _, err := os.Stat(filepath.Join(opts.ContextDir, dockerfile)); err != nil {
+		return "", fmt.Errorf("failed to find Dockerfile: %w", err)
+	}
+
+	buildArgs := make(map[string]*string, len(opts.BuildArgs))
+	for key, value := range opts.BuildArgs {
+		buildArgs[key] = &value
+	}
+
+	// Stream the tar to the daemon while it is being written instead of buffering the whole context
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeContextTar(pw, opts.ContextDir))
+	}()
+	defer pr.Close()
+
+	resp, err := c.cli.ImageBuild(ctx, pr, build.ImageBuildOptions{
+		Tags:        []string{opts.Tag},
+		Dockerfile:  filepath.ToSlash(dockerfile),
+		BuildArgs:   buildArgs,
+		Remove:      true,
+		ForceRemove: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to build image %s: %w", opts.Tag, err)
+	}
+	defer resp.Body.Close()
+
+	var imageID string
+	auxCallback := func(msg jsonmessage.JSONMessage) {
+		var aux struct {
+			ID string `json:"ID"`
+		}
+		if msg.Aux != nil && json.Unmarshal(*msg.Aux, &aux) == nil && aux.ID != "" {
+			imageID = aux.ID
+		}
+	}
+
+	// Build failures are only reported inside the output stream
+	if err := jsonmessage.DisplayJSONMessagesStream(resp.Body, out, 0, false, auxCallback); err != nil {
+		return "", fmt.Errorf("failed to build image %s: %w", opts.Tag, err)
+	}
+
+	return imageID, nil
+}
+
+// writeContextTar writes every regular file, directory and symlink under dir to w as a tar stream
+func writeContextTar(w io.Writer, dir string) error {
+	tw := tar.NewWriter(w)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		} else if !info.Mode().IsRegular() && !info.IsDir() {
+			// Sockets, devices and pipes cannot be part of a build context
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive build context: %w", err)
+	}
+
+	return tw.Close()
+}
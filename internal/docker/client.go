@@ -2,17 +2,35 @@ package docker
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/go-connections/nat"
 )
 
+const (
+	// DefaultRestartPolicy is applied to containers that do not specify a restart policy
+	DefaultRestartPolicy = "unless-stopped"
+
+	// DefaultLogDriver is applied to containers that do not specify a logging driver
+	DefaultLogDriver = "json-file"
+)
+
+// defaultLogOptions caps json-file logs so verbose containers cannot exhaust the disk
+var defaultLogOptions = map[string]string{
+	"max-size": "10m",
+	"max-file": "3",
+}
+
 type Client struct {
 	cli *client.Client
 }
@@ -56,6 +74,24 @@ func (c *Client) PullImage(ctx context.Context, imageName string) error {
 	return nil
 }
 
+// PushImage pushes an image to its registry. Registries that require
+// authentication are not supported.
+func (c *Client) PushImage(ctx context.Context, imageName string) error {
+	// The daemon rejects pushes without an auth header, even for registries that need none
+	reader, err := c.cli.ImagePush(ctx, imageName, image.PushOptions{RegistryAuth: base64.URLEncoding.EncodeToString([]byte("{}"))})
+	if err != nil {
+		return fmt.Errorf("failed to push image %s: %w", imageName, err)
+	}
+	defer reader.Close()
+
+	// Push failures are only reported inside the progress stream
+	if err := jsonmessage.DisplayJSONMessagesStream(reader, io.Discard, 0, false, nil); err != nil {
+		return fmt.Errorf("failed to push image %s: %w", imageName, err)
+	}
+
+	return nil
+}
+
 func (c *Client) RunContainer(ctx context.Context, opts RunOptions) error {
 	// Parse port mappings using Docker SDK utility
 	var portBindings nat.PortMap
@@ -86,6 +122,7 @@ func (c *Client) RunContainer(ctx context.Context, opts RunOptions) error {
 	// Create container configuration
 	config := &container.Config{
 		Image:        opts.Image,
+		Cmd:          opts.Command,
 		Env:          env,
 		ExposedPorts: exposedPorts,
 		Labels:       opts.Labels,
@@ -94,7 +131,17 @@ func (c *Client) RunContainer(ctx context.Context, opts RunOptions) error {
 	// Set restart policy with default fallback
 	restartPolicy := opts.RestartPolicy
 	if restartPolicy == "" {
-		restartPolicy = "unless-stopped" // Default policy
+		restartPolicy = DefaultRestartPolicy
+	}
+
+	// Set logging driver with rotation defaults for json-file
+	logDriver := opts.LogDriver
+	if logDriver == "" {
+		logDriver = DefaultLogDriver
+	}
+	logOptions := opts.LogOptions
+	if logDriver == DefaultLogDriver && len(logOptions) == 0 {
+		logOptions = defaultLogOptions
 	}
 
 	hostConfig := &container.HostConfig{
@@ -103,6 +150,10 @@ func (c *Client) RunContainer(ctx context.Context, opts RunOptions) error {
 			Name: container.RestartPolicyMode(restartPolicy),
 		},
 		Binds: opts.Volumes,
+		LogConfig: container.LogConfig{
+			Type:   logDriver,
+			Config: logOptions,
+		},
 	}
 
 	// Configure networks
@@ -119,6 +170,9 @@ func (c *Client) RunContainer(ctx context.Context, opts RunOptions) error {
 	if err != nil {
 		return fmt.Errorf("failed to create container %s: %w", opts.Name, err)
 	}
+	if opts.CreateOnly {
+		return nil
+	}
 
 	// Cleanup on failure
 	if err := c.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
@@ -133,9 +187,14 @@ func (c *Client) RunContainer(ctx context.Context, opts RunOptions) error {
 }
 
 func (c *Client) StopContainer(ctx context.Context, name string) error {
-	timeout := 30 // 30 seconds timeout
+	return c.StopContainerWithTimeout(ctx, name, 30*time.Second)
+}
+
+// StopContainerWithTimeout stops a container, killing it if it has not exited after timeout
+func (c *Client) StopContainerWithTimeout(ctx context.Context, name string, timeout time.Duration) error {
+	seconds := int(timeout.Seconds())
 	options := container.StopOptions{
-		Timeout: &timeout,
+		Timeout: &seconds,
 	}
 
 	if err := c.cli.ContainerStop(ctx, name, options); err != nil {
@@ -151,6 +210,28 @@ func (c *Client) StartContainer(ctx context.Context, name string) error {
 	return nil
 }
 
+func (c *Client) PauseContainer(ctx context.Context, name string) error {
+	if err := c.cli.ContainerPause(ctx, name); err != nil {
+		return fmt.Errorf("failed to pause container %s: %w", name, err)
+	}
+	return nil
+}
+
+func (c *Client) UnpauseContainer(ctx context.Context, name string) error {
+	if err := c.cli.ContainerUnpause(ctx, name); err != nil {
+		return fmt.Errorf("failed to unpause container %s: %w", name, err)
+	}
+	return nil
+}
+
+// ContainerRename renames a container; labels and other configuration are left unchanged
+func (c *Client) ContainerRename(ctx context.Context, oldName, newName string) error {
+	if err := c.cli.ContainerRename(ctx, oldName, newName); err != nil {
+		return fmt.Errorf("failed to rename container %s to %s: %w", oldName, newName, err)
+	}
+	return nil
+}
+
 func (c *Client) RemoveContainer(ctx context.Context, name string, force bool) error {
 	options := container.RemoveOptions{
 		Force: force,
@@ -162,6 +243,20 @@ func (c *Client) RemoveContainer(ctx context.Context, name string, force bool) e
 	return nil
 }
 
+// RemoveContainerAndVolumes force-removes a container along with its anonymous volumes.
+// Named volumes are kept.
+func (c *Client) RemoveContainerAndVolumes(ctx context.Context, name string) error {
+	options := container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}
+
+	if err := c.cli.ContainerRemove(ctx, name, options); err != nil {
+		return fmt.Errorf("failed to remove container %s: %w", name, err)
+	}
+	return nil
+}
+
 func (c *Client) ListContainers(ctx context.Context) ([]Container, error) {
 	containers, err := c.cli.ContainerList(ctx, container.ListOptions{All: true})
 	if err != nil {
@@ -228,3 +323,177 @@ func (c *Client) GetContainerStatus(ctx context.Context, name string) (string, e
 
 	return "", fmt.Errorf("container %s not found", name)
 }
+
+func (c *Client) ContainerInspect(ctx context.Context, name string) (*ContainerDetail, error) {
+	return inspectContainer(ctx, c.cli, name)
+}
+
+// containerInspector is the part of the Docker SDK client that inspectContainer uses
+type containerInspector interface {
+	ContainerInspect(ctx context.Context, containerID string) (container.InspectResponse, error)
+}
+
+func inspectContainer(ctx context.Context, api containerInspector, name string) (*ContainerDetail, error) {
+	resp, err := api.ContainerInspect(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect container %s: %w", name, err)
+	}
+
+	detail := &ContainerDetail{
+		ID:           resp.ID,
+		Name:         strings.TrimPrefix(resp.Name, "/"),
+		ImageID:      resp.Image,
+		Env:          make(map[string]string),
+		PortBindings: make(map[string][]PortBinding),
+		Networks:     make(map[string]ContainerNetwork),
+	}
+
+	if resp.State != nil {
+		detail.Status = resp.State.Status
+		// Docker reports 0001-01-01T00:00:00Z for events that never happened
+		if t, err := time.Parse(time.RFC3339Nano, resp.State.StartedAt); err == nil && t.Year() > 1 {
+			detail.StartedAt = t
+		}
+		if t, err := time.Parse(time.RFC3339Nano, resp.State.FinishedAt); err == nil && t.Year() > 1 {
+			detail.FinishedAt = t
+		}
+		if resp.State.Health != nil {
+			detail.Health = &HealthState{
+				Status:        string(resp.State.Health.Status),
+				FailingStreak: resp.State.Health.FailingStreak,
+			}
+		}
+	}
+
+	if resp.Config != nil {
+		detail.Image = resp.Config.Image
+		detail.Labels = resp.Config.Labels
+		detail.User = resp.Config.User
+		if hc := resp.Config.Healthcheck; hc != nil && len(hc.Test) > 0 && hc.Test[0] != "NONE" {
+			detail.HasHealthcheck = true
+		}
+		for _, env := range resp.Config.Env {
+			key, value, _ := strings.Cut(env, "=")
+			detail.Env[key] = value
+		}
+	}
+
+	if resp.HostConfig != nil {
+		detail.Volumes = resp.HostConfig.Binds
+		detail.RestartPolicy = string(resp.HostConfig.RestartPolicy.Name)
+		detail.MemoryLimit = resp.HostConfig.Memory
+		detail.NanoCPUs = resp.HostConfig.NanoCPUs
+		detail.CPUShares = resp.HostConfig.CPUShares
+		detail.Privileged = resp.HostConfig.Privileged
+		for port, bindings := range resp.HostConfig.PortBindings {
+			for _, binding := range bindings {
+				detail.PortBindings[string(port)] = append(detail.PortBindings[string(port)], PortBinding{
+					HostIP:   binding.HostIP,
+					HostPort: binding.HostPort,
+				})
+				detail.Ports = append(detail.Ports, fmt.Sprintf("%s:%s", binding.HostPort, port.Port()))
+			}
+		}
+		sort.Strings(detail.Ports)
+	}
+
+	for _, mount := range resp.Mounts {
+		detail.Mounts = append(detail.Mounts, MountPoint{
+			Type:        string(mount.Type),
+			Name:        mount.Name,
+			Source:      mount.Source,
+			Destination: mount.Destination,
+			ReadOnly:    !mount.RW,
+		})
+	}
+
+	if resp.NetworkSettings != nil {
+		for networkName, endpoint := range resp.NetworkSettings.Networks {
+			if endpoint == nil {
+				detail.Networks[networkName] = ContainerNetwork{}
+				continue
+			}
+			detail.Networks[networkName] = ContainerNetwork{
+				NetworkID: endpoint.NetworkID,
+				IPAddress: endpoint.IPAddress,
+				Gateway:   endpoint.Gateway,
+				Aliases:   endpoint.Aliases,
+			}
+		}
+	}
+
+	return detail, nil
+}
+
+// WaitHealthy polls a container until its health check passes or, for containers
+// without a health check, until it is running. It fails as soon as the container
+// exits or is reported unhealthy.
+func (c *Client) WaitHealthy(ctx context.Context, name string) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.cli.ContainerInspect(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed to inspect container %s: %w", name, err)
+		}
+
+		if state := resp.State; state != nil {
+			switch {
+			case state.Status == container.StateExited || state.Status == container.StateDead:
+				return fmt.Errorf("container %s exited with code %d", name, state.ExitCode)
+			case state.Health == nil && state.Running:
+				return nil
+			case state.Health != nil && state.Health.Status == container.Healthy:
+				return nil
+			case state.Health != nil && state.Health.Status == container.Unhealthy:
+				return fmt.Errorf("container %s is unhealthy", name)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("container %s did not become healthy: %w", name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetContainerHealthHistory returns the health check attempts Docker keeps for a
+// container, oldest first. It is empty when the container has no health check.
+func (c *Client) GetContainerHealthHistory(ctx context.Context, name string) ([]HealthCheckResult, error) {
+	resp, err := c.cli.ContainerInspect(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect container %s: %w", name, err)
+	}
+
+	if resp.State == nil || resp.State.Health == nil {
+		return nil, nil
+	}
+
+	history := make([]HealthCheckResult, 0, len(resp.State.Health.Log))
+	for _, entry := range resp.State.Health.Log {
+		if entry == nil {
+			continue
+		}
+		history = append(history, HealthCheckResult{
+			Start:    entry.Start,
+			End:      entry.End,
+			ExitCode: entry.ExitCode,
+			Output:   entry.Output,
+		})
+	}
+	return history, nil
+}
+
+func (c *Client) GetContainerLabels(ctx context.Context, name string) (map[string]string, error) {
+	resp, err := c.cli.ContainerInspect(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect container %s: %w", name, err)
+	}
+
+	if resp.Config == nil {
+		return map[string]string{}, nil
+	}
+	return resp.Config.Labels, nil
+}
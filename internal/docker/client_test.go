@@ -0,0 +1,177 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInspector returns a canned inspect response, standing in for the Docker SDK client
+type fakeInspector struct {
+	resp container.InspectResponse
+	err  error
+	name string
+}
+
+func (f *fakeInspector) ContainerInspect(_ context.Context, containerID string) (container.InspectResponse, error) {
+	f.name = containerID
+	return f.resp, f.err
+}
+
+func inspectResponse() container.InspectResponse {
+	return container.InspectResponse{
+		ContainerJSONBase: &container.ContainerJSONBase{
+			ID:    "0123456789abcdef",
+			Name:  "/finks-web",
+			Image: "sha256:abc",
+			State: &container.State{
+				Status:     "running",
+				StartedAt:  "2026-10-01T12:00:00.5Z",
+				FinishedAt: "0001-01-01T00:00:00Z",
+				Health: &container.Health{
+					Status:        container.Unhealthy,
+					FailingStreak: 3,
+				},
+			},
+			HostConfig: &container.HostConfig{
+				RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+				PortBindings: nat.PortMap{
+					"80/tcp": {{HostIP: "0.0.0.0", HostPort: "8080"}, {HostIP: "::", HostPort: "8080"}},
+					"53/udp": {{HostPort: "5353"}},
+				},
+			},
+		},
+		Config: &container.Config{
+			Image:  "nginx:latest",
+			Env:    []string{"PORT=80", "DSN=postgres://u:p@db/app?sslmode=disable", "EMPTY=", "NOVALUE"},
+			Labels: map[string]string{"finks.app": "web"},
+		},
+		Mounts: []container.MountPoint{
+			{Type: "volume", Name: "data", Source: "/var/lib/docker/volumes/data/_data", Destination: "/data", RW: true},
+			{Type: "bind", Source: "/etc/app", Destination: "/config", RW: false},
+		},
+		NetworkSettings: &container.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"traefik-net": {NetworkID: "net1", IPAddress: "172.18.0.5", Gateway: "172.18.0.1", Aliases: []string{"web"}},
+				"bridge":      nil,
+			},
+		},
+	}
+}
+
+func TestInspectContainer(t *testing.T) {
+	api := &fakeInspector{resp: inspectResponse()}
+
+	detail, err := inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+
+	assert.Equal(t, "finks-web", api.name)
+	assert.Equal(t, "0123456789abcdef", detail.ID)
+	assert.Equal(t, "finks-web", detail.Name)
+	assert.Equal(t, "nginx:latest", detail.Image)
+	assert.Equal(t, "sha256:abc", detail.ImageID)
+	assert.Equal(t, "running", detail.Status)
+	assert.Equal(t, "unless-stopped", detail.RestartPolicy)
+	assert.Equal(t, map[string]string{"finks.app": "web"}, detail.Labels)
+	assert.Equal(t, &HealthState{Status: "unhealthy", FailingStreak: 3}, detail.Health)
+}
+
+func TestInspectContainerTimes(t *testing.T) {
+	api := &fakeInspector{resp: inspectResponse()}
+
+	detail, err := inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 1, 12, 0, 0, 500000000, time.UTC), detail.StartedAt.UTC())
+	assert.True(t, detail.FinishedAt.IsZero(), "the zero time Docker reports for a running container is left unset")
+
+	api.resp.State.StartedAt = "0001-01-01T00:00:00Z"
+	api.resp.State.FinishedAt = ""
+	detail, err = inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+	assert.True(t, detail.StartedAt.IsZero())
+	assert.True(t, detail.FinishedAt.IsZero())
+}
+
+func TestInspectContainerEnv(t *testing.T) {
+	api := &fakeInspector{resp: inspectResponse()}
+
+	detail, err := inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"PORT":    "80",
+		"DSN":     "postgres://u:p@db/app?sslmode=disable",
+		"EMPTY":   "",
+		"NOVALUE": "",
+	}, detail.Env)
+}
+
+func TestInspectContainerPorts(t *testing.T) {
+	api := &fakeInspector{resp: inspectResponse()}
+
+	detail, err := inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]PortBinding{
+		"80/tcp": {{HostIP: "0.0.0.0", HostPort: "8080"}, {HostIP: "::", HostPort: "8080"}},
+		"53/udp": {{HostPort: "5353"}},
+	}, detail.PortBindings)
+	assert.Equal(t, []string{"5353:53", "8080:80", "8080:80"}, detail.Ports)
+}
+
+func TestInspectContainerMounts(t *testing.T) {
+	api := &fakeInspector{resp: inspectResponse()}
+
+	detail, err := inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+	require.Len(t, detail.Mounts, 2)
+	assert.Equal(t, MountPoint{
+		Type:        "volume",
+		Name:        "data",
+		Source:      "/var/lib/docker/volumes/data/_data",
+		Destination: "/data",
+		ReadOnly:    false,
+	}, detail.Mounts[0])
+	assert.True(t, detail.Mounts[1].ReadOnly, "a mount without RW is read-only")
+	assert.Empty(t, detail.Mounts[1].Name)
+}
+
+func TestInspectContainerNetworks(t *testing.T) {
+	api := &fakeInspector{resp: inspectResponse()}
+
+	detail, err := inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]ContainerNetwork{
+		"traefik-net": {NetworkID: "net1", IPAddress: "172.18.0.5", Gateway: "172.18.0.1", Aliases: []string{"web"}},
+		"bridge":      {},
+	}, detail.Networks)
+	assert.Equal(t, []string{"bridge", "traefik-net"}, detail.NetworkNames())
+}
+
+func TestInspectContainerWithoutState(t *testing.T) {
+	api := &fakeInspector{resp: container.InspectResponse{
+		ContainerJSONBase: &container.ContainerJSONBase{ID: "abc", Name: "/finks-web"},
+	}}
+
+	detail, err := inspectContainer(context.Background(), api, "finks-web")
+	require.NoError(t, err)
+	assert.Empty(t, detail.Status)
+	assert.Nil(t, detail.Health)
+	assert.Empty(t, detail.Env)
+	assert.Empty(t, detail.PortBindings)
+	assert.Empty(t, detail.Networks)
+}
+
+func TestInspectContainerError(t *testing.T) {
+	api := &fakeInspector{err: errors.New("no such container")}
+
+	_, err := inspectContainer(context.Background(), api, "finks-missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to inspect container finks-missing")
+	assert.ErrorIs(t, err, api.err)
+}
@@ -0,0 +1,183 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+)
+
+// ErrPathNotFound is returned when a path does not exist inside a container
+var ErrPathNotFound = errors.New("path not found in container")
+
+// CopyFromContainer reads a single file out of a container. The container does not need to be running.
+func (c *Client) CopyFromContainer(ctx context.Context, name, srcPath string) ([]byte, error) {
+	reader, _, err := c.cli.CopyFromContainer(ctx, name, srcPath)
+	if cerrdefs.IsNotFound(err) {
+		if exists, _ := c.ContainerExists(ctx, name); exists {
+			return nil, fmt.Errorf("%w: %s in %s", ErrPathNotFound, srcPath, name)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy %s from container %s: %w", srcPath, name, err)
+	}
+	defer reader.Close()
+
+	// The file is returned as a single-entry tar archive
+	tr := tar.NewReader(reader)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from container %s: %w", srcPath, name, err)
+	}
+	if header.Typeflag != tar.TypeReg {
+		return nil, fmt.Errorf("%s in container %s is not a regular file", srcPath, name)
+	}
+
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from container %s: %w", srcPath, name, err)
+	}
+	return data, nil
+}
+
+// CopyToContainer writes data to dstPath inside a container with the given file mode,
+// replacing any existing file. The parent directory must already exist.
+func (c *Client) CopyToContainer(ctx context.Context, name, dstPath string, data []byte, mode int64) error {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	header := &tar.Header{
+		Name:    path.Base(dstPath),
+		Mode:    mode,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to build archive for %s: %w", dstPath, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to build archive for %s: %w", dstPath, err)
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to build archive for %s: %w", dstPath, err)
+	}
+
+	if err := c.cli.CopyToContainer(ctx, name, path.Dir(dstPath), &buf, container.CopyToContainerOptions{}); err != nil {
+		return fmt.Errorf("failed to copy %s to container %s: %w", dstPath, name, err)
+	}
+	return nil
+}
+
+// CopyBetweenContainers streams srcPath out of the src container into dstPath in the dst
+// container, which must be running. The contents of a directory are copied into dstPath,
+// which is created if needed, and a file is written to dstPath. The src container does
+// not need to be running. progress, if set, is called with the number of bytes copied so
+// far. It returns the total number of bytes copied.
+func (c *Client) CopyBetweenContainers(ctx context.Context, src, srcPath, dst, dstPath string, progress func(int64)) (int64, error) {
+	reader, _, err := c.cli.CopyFromContainer(ctx, src, srcPath)
+	if cerrdefs.IsNotFound(err) {
+		if exists, _ := c.ContainerExists(ctx, src); exists {
+			return 0, fmt.Errorf("%w: %s in %s", ErrPathNotFound, srcPath, src)
+		}
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy %s from container %s: %w", srcPath, src, err)
+	}
+	defer reader.Close()
+
+	// The archive holds srcPath under its base name; it is renamed on the way through
+	tr := tar.NewReader(reader)
+	first, err := tr.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s from container %s: %w", srcPath, src, err)
+	}
+	targetDir, rename := dstPath, "."
+	if first.Typeflag != tar.TypeDir {
+		targetDir, rename = path.Dir(dstPath), path.Base(dstPath)
+	}
+
+	pr, pw := io.Pipe()
+	counter := &countingWriter{w: pw, progress: progress}
+	done := make(chan error, 1)
+	go func() {
+		tw := tar.NewWriter(counter)
+		err := renameArchive(tr, tw, first, strings.TrimSuffix(first.Name, "/"), rename)
+		if err == nil {
+			err = tw.Close()
+		}
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	var stderr bytes.Buffer
+	cmd := []string{"sh", "-c", `mkdir -p "$1" && tar xf - -C "$1"`, "sh", targetDir}
+	exitCode, err := c.ExecInContainerWithInput(ctx, dst, cmd, pr, io.Discard, &stderr)
+	// Unblock the archive writer if tar stopped reading early
+	pr.Close()
+	copyErr := <-done
+
+	if err != nil {
+		return counter.n, err
+	}
+	if copyErr != nil && !errors.Is(copyErr, io.ErrClosedPipe) {
+		return counter.n, fmt.Errorf("failed to read %s from container %s: %w", srcPath, src, copyErr)
+	}
+	if exitCode != 0 {
+		return counter.n, fmt.Errorf("tar in container %s exited with code %d: %s", dst, exitCode, strings.TrimSpace(stderr.String()))
+	}
+	return counter.n, nil
+}
+
+// renameArchive copies the entries of tr, starting with first, to tw, replacing the
+// leading oldBase of each name and hard link target with newBase
+func renameArchive(tr *tar.Reader, tw *tar.Writer, first *tar.Header, oldBase, newBase string) error {
+	rename := func(name string) string {
+		rest, _ := strings.CutPrefix(strings.TrimSuffix(name, "/"), oldBase)
+		return newBase + rest
+	}
+
+	for header := first; ; {
+		header.Name = rename(header.Name)
+		if header.Typeflag == tar.TypeLink {
+			header.Linkname = rename(header.Linkname)
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+
+		var err error
+		header, err = tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w        io.Writer
+	n        int64
+	progress func(int64)
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	if w.progress != nil {
+		w.progress(w.n)
+	}
+	return n, err
+}
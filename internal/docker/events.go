@@ -0,0 +1,57 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// WatchContainerEvents streams Docker events for a container. since may be a
+// timestamp or a relative duration such as 1h; an empty since only reports new
+// events. The channel is closed when ctx is cancelled or the stream fails.
+func (c *Client) WatchContainerEvents(ctx context.Context, name, since string) (<-chan ContainerEvent, error) {
+	if _, err := c.cli.ContainerInspect(ctx, name); err != nil {
+		return nil, fmt.Errorf("failed to inspect container %s: %w", name, err)
+	}
+
+	args := filters.NewArgs()
+	args.Add("type", string(events.ContainerEventType))
+	args.Add("container", name)
+
+	messages, errs := c.cli.Events(ctx, events.ListOptions{
+		Since:   since,
+		Filters: args,
+	})
+
+	out := make(chan ContainerEvent)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case msg := <-messages:
+				event := ContainerEvent{
+					Type:       string(msg.Type),
+					Action:     string(msg.Action),
+					Time:       time.Unix(0, msg.TimeNano),
+					Attributes: msg.Actor.Attributes,
+				}
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			case err := <-errs:
+				if err != nil && ctx.Err() == nil {
+					slog.Error("container event stream failed", "container", name, "error", err)
+				}
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,55 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// ExecInContainer runs a command inside a running container, copies its output
+// to stdout and stderr and returns the command's exit code
+func (c *Client) ExecInContainer(ctx context.Context, name string, cmd []string, stdout, stderr io.Writer) (int, error) {
+	return c.ExecInContainerWithInput(ctx, name, cmd, nil, stdout, stderr)
+}
+
+// ExecInContainerWithInput is ExecInContainer with stdin, if not nil, streamed to the
+// command. Stdin is closed once it is exhausted, so the command sees end of file.
+func (c *Client) ExecInContainerWithInput(ctx context.Context, name string, cmd []string, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	exec, err := c.cli.ContainerExecCreate(ctx, name, container.ExecOptions{
+		Cmd:          cmd,
+		AttachStdin:  stdin != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return -1, fmt.Errorf("failed to create exec in container %s: %w", name, err)
+	}
+
+	attach, err := c.cli.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return -1, fmt.Errorf("failed to attach to exec in container %s: %w", name, err)
+	}
+	defer attach.Close()
+
+	if stdin != nil {
+		go func() {
+			// A copy error surfaces as the command failing on truncated input
+			io.Copy(attach.Conn, stdin)
+			attach.CloseWrite()
+		}()
+	}
+
+	if _, err := stdcopy.StdCopy(stdout, stderr, attach.Reader); err != nil {
+		return -1, fmt.Errorf("failed to read exec output from container %s: %w", name, err)
+	}
+
+	inspect, err := c.cli.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return -1, fmt.Errorf("failed to inspect exec in container %s: %w", name, err)
+	}
+
+	return inspect.ExitCode, nil
+}
@@ -0,0 +1,44 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// StreamContainerLogs copies a container's logs to stdout and stderr until the
+// log stream ends or ctx is cancelled
+func (c *Client) StreamContainerLogs(ctx context.Context, name string, opts LogOptions, stdout, stderr io.Writer) error {
+	inspect, err := c.cli.ContainerInspect(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to inspect container %s: %w", name, err)
+	}
+
+	reader, err := c.cli.ContainerLogs(ctx, name, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     opts.Follow,
+		Tail:       opts.Tail,
+		Since:      opts.Since,
+		Timestamps: opts.Timestamps,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get logs for container %s: %w", name, err)
+	}
+	defer reader.Close()
+
+	// Containers with a TTY produce a raw stream; otherwise stdout and stderr are multiplexed
+	if inspect.Config != nil && inspect.Config.Tty {
+		_, err = io.Copy(stdout, reader)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, reader)
+	}
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to read logs for container %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -2,17 +2,52 @@ package docker
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/docker/docker/api/types/network"
 )
 
+// ErrContainerNotOnNetwork is returned when a container exists but is not attached to the requested network
+var ErrContainerNotOnNetwork = errors.New("container is not on network")
 
+// ManagedNetworkLabel marks networks created with 'finks network create'
+const ManagedNetworkLabel = "finks.managed"
 
-func (c *Client) CreateNetwork(ctx context.Context, name, driver string, labels map[string]string) (string, error) {
+// DefaultNetworkLabels returns the labels finks puts on the networks it creates
+func DefaultNetworkLabels() map[string]string {
+	return map[string]string{ManagedNetworkLabel: "true"}
+}
+
+// minIPv6EngineVersion is the first Docker Engine release with IPv6 on user-defined bridges enabled by default
+const minIPv6EngineVersion = 27
+
+func (c *Client) CreateNetwork(ctx context.Context, name string, opts NetworkCreateOptions) (string, error) {
 	options := network.CreateOptions{
-		Driver: driver,
-		Labels: labels,
+		Driver:   opts.Driver,
+		Labels:   opts.Labels,
+		Internal: opts.Internal,
+		Options:  opts.Options,
+	}
+
+	if opts.EnableIPv6 {
+		enableIPv6 := true
+		options.EnableIPv6 = &enableIPv6
+
+		if opts.IPv6Subnet != "" {
+			options.IPAM = &network.IPAM{
+				Driver: "default",
+				Config: []network.IPAMConfig{
+					{
+						Subnet:  opts.IPv6Subnet,
+						Gateway: opts.IPv6Gateway,
+					},
+				},
+			}
+		}
 	}
 
 	resp, err := c.cli.NetworkCreate(ctx, name, options)
@@ -23,6 +58,26 @@ func (c *Client) CreateNetwork(ctx context.Context, name, driver string, labels
 	return resp.ID, nil
 }
 
+// SupportsIPv6 reports whether the Docker daemon is expected to handle IPv6 bridge networks
+func (c *Client) SupportsIPv6(ctx context.Context) (bool, error) {
+	info, err := c.cli.Info(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get Docker daemon info: %w", err)
+	}
+
+	if info.OSType != "linux" {
+		return false, nil
+	}
+
+	major, _, _ := strings.Cut(info.ServerVersion, ".")
+	version, err := strconv.Atoi(major)
+	if err != nil {
+		return false, nil
+	}
+
+	return version >= minIPv6EngineVersion, nil
+}
+
 func (c *Client) NetworkExists(ctx context.Context, name string) (bool, error) {
 	networks, err := c.cli.NetworkList(ctx, network.ListOptions{})
 	if err != nil {
@@ -58,6 +113,13 @@ func (c *Client) GetNetworkInfo(ctx context.Context, name string) (*NetworkInfo,
 		info.Gateway = config.Gateway
 	}
 
+	for _, endpoint := range resp.Containers {
+		ip, _, _ := strings.Cut(endpoint.IPv4Address, "/")
+		info.Containers = append(info.Containers, NetworkContainer{Name: endpoint.Name, IPv4: ip})
+	}
+	sort.Slice(info.Containers, func(i, j int) bool { return info.Containers[i].Name < info.Containers[j].Name })
+	info.ContainerCount = len(resp.Containers)
+
 	return info, nil
 }
 
@@ -70,6 +132,41 @@ func (c *Client) ConnectContainerToNetwork(ctx context.Context, networkName, con
 	return nil
 }
 
+// GetContainerNetworks returns the names of the networks a container is attached to
+func (c *Client) GetContainerNetworks(ctx context.Context, containerName string) ([]string, error) {
+	resp, err := c.cli.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect container %s: %w", containerName, err)
+	}
+
+	var networks []string
+	if resp.NetworkSettings != nil {
+		for name := range resp.NetworkSettings.Networks {
+			networks = append(networks, name)
+		}
+	}
+
+	return networks, nil
+}
+
+// GetContainerIP returns the IPv4 address of a container on the given network
+func (c *Client) GetContainerIP(ctx context.Context, containerName, networkName string) (string, error) {
+	resp, err := c.cli.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect container %s: %w", containerName, err)
+	}
+
+	if resp.NetworkSettings == nil {
+		return "", fmt.Errorf("%w: %s is not attached to %s", ErrContainerNotOnNetwork, containerName, networkName)
+	}
+	endpoint, ok := resp.NetworkSettings.Networks[networkName]
+	if !ok || endpoint == nil {
+		return "", fmt.Errorf("%w: %s is not attached to %s", ErrContainerNotOnNetwork, containerName, networkName)
+	}
+
+	return endpoint.IPAddress, nil
+}
+
 func (c *Client) DisconnectContainerFromNetwork(ctx context.Context, networkName, containerName string) error {
 	err := c.cli.NetworkDisconnect(ctx, networkName, containerName, false)
 	if err != nil {
@@ -101,6 +198,13 @@ func (c *Client) ListNetworks(ctx context.Context) ([]NetworkInfo, error) {
 			info.Gateway = config.Gateway
 		}
 
+		// The list endpoint leaves out attached containers, so count them by inspecting
+		resp, err := c.cli.NetworkInspect(ctx, net.ID, network.InspectOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect network %s: %w", net.Name, err)
+		}
+		info.ContainerCount = len(resp.Containers)
+
 		result = append(result, info)
 	}
 
@@ -133,10 +237,120 @@ func (c *Client) EnsureNetwork(ctx context.Context, name, driver string, labels
 	}
 
 	// Create network
-	networkID, err := c.CreateNetwork(ctx, name, driver, labels)
+	networkID, err := c.CreateNetwork(ctx, name, NetworkCreateOptions{Driver: driver, Labels: labels})
 	if err != nil {
 		return "", fmt.Errorf("failed to create network: %w", err)
 	}
 
 	return networkID, nil
-}
\ No newline at end of file
+}
+
+// RenameNetwork moves a network to a new name. Docker cannot rename networks, so the
+// attached containers are disconnected, the network is removed and created again under
+// newName with the same driver, IPAM configuration, options and labels, and the
+// containers are reconnected. If any step fails, the old network is restored and the
+// containers are reconnected to it. Containers keep their aliases, static addresses
+// and links on the network. It returns the names of the moved containers.
+func (c *Client) RenameNetwork(ctx context.Context, oldName, newName string) ([]string, error) {
+	resp, err := c.cli.NetworkInspect(ctx, oldName, network.InspectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect network %s: %w", oldName, err)
+	}
+
+	if exists, err := c.NetworkExists(ctx, newName); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, fmt.Errorf("network %s already exists", newName)
+	}
+
+	enableIPv4, enableIPv6 := resp.EnableIPv4, resp.EnableIPv6
+	options := network.CreateOptions{
+		Driver:     resp.Driver,
+		Scope:      resp.Scope,
+		EnableIPv4: &enableIPv4,
+		EnableIPv6: &enableIPv6,
+		IPAM:       &resp.IPAM,
+		Internal:   resp.Internal,
+		Attachable: resp.Attachable,
+		Options:    resp.Options,
+		Labels:     resp.Labels,
+	}
+
+	containers := make([]string, 0, len(resp.Containers))
+	for _, endpoint := range resp.Containers {
+		containers = append(containers, endpoint.Name)
+	}
+	sort.Strings(containers)
+
+	endpoints := make(map[string]*network.EndpointSettings, len(containers))
+	for _, name := range containers {
+		settings, err := c.endpointSettings(ctx, name, oldName)
+		if err != nil {
+			return nil, err
+		}
+		endpoints[name] = settings
+	}
+
+	// Rollback runs even if ctx was cancelled, so that containers are not left stranded
+	rollbackCtx := context.WithoutCancel(ctx)
+	reconnect := func(networkName string, names []string) {
+		for _, name := range names {
+			c.cli.NetworkConnect(rollbackCtx, networkName, name, endpoints[name])
+		}
+	}
+	restore := func() {
+		if _, err := c.cli.NetworkCreate(rollbackCtx, oldName, options); err == nil {
+			reconnect(oldName, containers)
+		}
+	}
+
+	for i, name := range containers {
+		if err := c.cli.NetworkDisconnect(ctx, oldName, name, false); err != nil {
+			reconnect(oldName, containers[:i])
+			return nil, fmt.Errorf("failed to disconnect container %s from network %s: %w", name, oldName, err)
+		}
+	}
+
+	if err := c.cli.NetworkRemove(ctx, oldName); err != nil {
+		reconnect(oldName, containers)
+		return nil, fmt.Errorf("failed to remove network %s: %w", oldName, err)
+	}
+
+	if _, err := c.cli.NetworkCreate(ctx, newName, options); err != nil {
+		restore()
+		return nil, fmt.Errorf("failed to create network %s: %w", newName, err)
+	}
+
+	for i, name := range containers {
+		if err := c.cli.NetworkConnect(ctx, newName, name, endpoints[name]); err != nil {
+			for _, connected := range containers[:i] {
+				c.cli.NetworkDisconnect(rollbackCtx, newName, connected, true)
+			}
+			c.cli.NetworkRemove(rollbackCtx, newName)
+			restore()
+			return nil, fmt.Errorf("failed to connect container %s to network %s: %w", name, newName, err)
+		}
+	}
+
+	return containers, nil
+}
+
+// endpointSettings returns the user-set configuration of a container on a network,
+// leaving out what Docker assigns when the container connects
+func (c *Client) endpointSettings(ctx context.Context, containerName, networkName string) (*network.EndpointSettings, error) {
+	resp, err := c.cli.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect container %s: %w", containerName, err)
+	}
+	if resp.NetworkSettings == nil || resp.NetworkSettings.Networks[networkName] == nil {
+		return nil, nil
+	}
+
+	endpoint := resp.NetworkSettings.Networks[networkName]
+	return &network.EndpointSettings{
+		IPAMConfig: endpoint.IPAMConfig,
+		Links:      endpoint.Links,
+		Aliases:    endpoint.Aliases,
+		DriverOpts: endpoint.DriverOpts,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+)
+
+// RecreateContainerWithLabels replaces a container with a copy that has updated labels.
+// Docker labels are immutable, so the container is recreated from its current config.
+// Labels are merged into the existing ones; a label with an empty value is removed.
+//
+// The new container is started before the old one is removed so Traefik always has a
+// backend to route to. Containers that publish host ports cannot run side by side,
+// so for those the old container is stopped first.
+func (c *Client) RecreateContainerWithLabels(ctx context.Context, name string, labels map[string]string) error {
+	old, err := c.cli.ContainerInspect(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to inspect container %s: %w", name, err)
+	}
+	if old.Config == nil || old.HostConfig == nil {
+		return fmt.Errorf("container %s has no configuration", name)
+	}
+
+	config := *old.Config
+	config.Labels = maps.Clone(old.Config.Labels)
+	if config.Labels == nil {
+		config.Labels = make(map[string]string)
+	}
+	for key, value := range labels {
+		if value == "" {
+			delete(config.Labels, key)
+		} else {
+			config.Labels[key] = value
+		}
+	}
+
+	// Let Docker assign the new container its own hostname unless one was set explicitly
+	if strings.HasPrefix(old.ID, config.Hostname) {
+		config.Hostname = ""
+	}
+
+	networkConfig := &network.NetworkingConfig{
+		EndpointsConfig: make(map[string]*network.EndpointSettings),
+	}
+	if old.NetworkSettings != nil {
+		for networkName, endpoint := range old.NetworkSettings.Networks {
+			networkConfig.EndpointsConfig[networkName] = &network.EndpointSettings{
+				Aliases:    endpoint.Aliases,
+				DriverOpts: endpoint.DriverOpts,
+			}
+		}
+	}
+
+	wasRunning := old.State != nil && old.State.Running
+	publishesPorts := len(old.HostConfig.PortBindings) > 0
+	if wasRunning && publishesPorts {
+		if err := c.StopContainer(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	// restoreOld brings the old container back if the replacement could not be started
+	restoreOld := func() {
+		if wasRunning && publishesPorts {
+			c.cli.ContainerStart(ctx, old.ID, container.StartOptions{})
+		}
+	}
+
+	tempName := name + "-next"
+	resp, err := c.cli.ContainerCreate(ctx, &config, old.HostConfig, networkConfig, nil, tempName)
+	if err != nil {
+		restoreOld()
+		return fmt.Errorf("failed to create container %s: %w", tempName, err)
+	}
+
+	if wasRunning {
+		if err := c.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+			c.cli.ContainerRemove(ctx, resp.ID, container.RemoveOptions{Force: true})
+			restoreOld()
+			return fmt.Errorf("failed to start container %s: %w", tempName, err)
+		}
+	}
+
+	if err := c.cli.ContainerRemove(ctx, old.ID, container.RemoveOptions{Force: true}); err != nil {
+		return fmt.Errorf("failed to remove container %s: %w", name, err)
+	}
+
+	if err := c.cli.ContainerRename(ctx, resp.ID, name); err != nil {
+		return fmt.Errorf("failed to rename container %s to %s: %w", tempName, name, err)
+	}
+
+	return nil
+}
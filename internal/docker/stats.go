@@ -0,0 +1,94 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// GetContainerStats returns a single resource usage sample for a container
+func (c *Client) GetContainerStats(ctx context.Context, name string) (*ContainerStats, error) {
+	// stream=false waits for a second sample so that CPU usage can be computed
+	resp, err := c.cli.ContainerStats(ctx, name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats for container %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	var raw container.StatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode stats for container %s: %w", name, err)
+	}
+
+	stats := &ContainerStats{
+		CPUPercent:  calculateCPUPercent(raw),
+		MemoryUsage: memoryUsage(raw.MemoryStats),
+		MemoryLimit: raw.MemoryStats.Limit,
+	}
+
+	if stats.MemoryLimit > 0 {
+		stats.MemoryPercent = float64(stats.MemoryUsage) / float64(stats.MemoryLimit) * 100.0
+	}
+
+	for _, net := range raw.Networks {
+		stats.NetworkRx += net.RxBytes
+		stats.NetworkTx += net.TxBytes
+	}
+
+	return stats, nil
+}
+
+// GetContainerNetworkCounters returns the cumulative network traffic of a container
+// across all its interfaces. Unlike GetContainerStats it does not wait for a second sample.
+func (c *Client) GetContainerNetworkCounters(ctx context.Context, name string) (*NetworkCounters, error) {
+	resp, err := c.cli.ContainerStatsOneShot(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats for container %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	var raw container.StatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode stats for container %s: %w", name, err)
+	}
+
+	counters := &NetworkCounters{}
+	for _, net := range raw.Networks {
+		counters.RxBytes += net.RxBytes
+		counters.TxBytes += net.TxBytes
+		counters.RxPackets += net.RxPackets
+		counters.TxPackets += net.TxPackets
+	}
+	return counters, nil
+}
+
+// calculateCPUPercent mirrors the calculation used by `docker stats`
+func calculateCPUPercent(stats container.StatsResponse) float64 {
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+	return cpuDelta / systemDelta * onlineCPUs * 100.0
+}
+
+// memoryUsage excludes the page cache, matching `docker stats`
+func memoryUsage(mem container.MemoryStats) uint64 {
+	// cgroup v2
+	if inactive, ok := mem.Stats["inactive_file"]; ok && inactive < mem.Usage {
+		return mem.Usage - inactive
+	}
+	// cgroup v1
+	if cache, ok := mem.Stats["total_inactive_file"]; ok && cache < mem.Usage {
+		return mem.Usage - cache
+	}
+	return mem.Usage
+}
@@ -0,0 +1,82 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// TaskOptions describes a one-off container that runs a command to completion
+type TaskOptions struct {
+	Image      string
+	Entrypoint []string
+	EnvVars    map[string]string
+	Volumes    []string
+	Networks   []string
+}
+
+// RunTask runs a one-off container, copies its output to stdout and stderr and
+// returns its exit code. The container is always removed afterwards. If ctx is
+// cancelled or times out, the container is killed.
+func (c *Client) RunTask(ctx context.Context, opts TaskOptions, stdout, stderr io.Writer) (int, error) {
+	var env []string
+	for key, value := range opts.EnvVars {
+		env = append(env, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	networkConfig := &network.NetworkingConfig{}
+	if len(opts.Networks) > 0 {
+		networkConfig.EndpointsConfig = make(map[string]*network.EndpointSettings)
+		for _, networkName := range opts.Networks {
+			networkConfig.EndpointsConfig[networkName] = &network.EndpointSettings{}
+		}
+	}
+
+	resp, err := c.cli.ContainerCreate(ctx,
+		&container.Config{
+			Image:        opts.Image,
+			Entrypoint:   opts.Entrypoint,
+			Env:          env,
+			AttachStdout: true,
+			AttachStderr: true,
+		},
+		&container.HostConfig{Binds: opts.Volumes},
+		networkConfig, nil, "")
+	if err != nil {
+		return -1, fmt.Errorf("failed to create task container: %w", err)
+	}
+	// Clean up with a fresh context so a timed out task is still removed
+	defer c.cli.ContainerRemove(context.WithoutCancel(ctx), resp.ID, container.RemoveOptions{Force: true})
+
+	attach, err := c.cli.ContainerAttach(ctx, resp.ID, container.AttachOptions{Stream: true, Stdout: true, Stderr: true})
+	if err != nil {
+		return -1, fmt.Errorf("failed to attach to task container: %w", err)
+	}
+	defer attach.Close()
+
+	if err := c.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		return -1, fmt.Errorf("failed to start task container: %w", err)
+	}
+
+	copyDone := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(stdout, stderr, attach.Reader)
+		copyDone <- err
+	}()
+
+	waitCh, errCh := c.cli.ContainerWait(ctx, resp.ID, container.WaitConditionNotRunning)
+	select {
+	case result := <-waitCh:
+		<-copyDone
+		return int(result.StatusCode), nil
+	case err := <-errCh:
+		if ctx.Err() != nil {
+			return -1, fmt.Errorf("task did not finish in time: %w", ctx.Err())
+		}
+		return -1, fmt.Errorf("failed to wait for task container: %w", err)
+	}
+}
@@ -1,5 +1,10 @@
 package docker
 
+import (
+	"sort"
+	"time"
+)
+
 type RunOptions struct {
 	Name          string
 	Image         string
@@ -9,6 +14,10 @@ type RunOptions struct {
 	Labels        map[string]string // Added for Traefik labels
 	Networks      []string          // Added for network connections
 	RestartPolicy string            // Docker restart policy (no, always, unless-stopped, on-failure)
+	LogDriver     string            // Docker logging driver, defaults to json-file
+	LogOptions    map[string]string // Logging driver options (e.g. max-size, max-file)
+	Command       []string          // Overrides the image's default command when set
+	CreateOnly    bool              // Creates the container without starting it
 }
 
 type Container struct {
@@ -18,6 +27,123 @@ type Container struct {
 	Ports  string
 }
 
+type ContainerDetail struct {
+	ID            string
+	Name          string
+	Image         string
+	ImageID       string
+	Status        string
+	StartedAt     time.Time // zero if the container never started
+	FinishedAt    time.Time // zero if the container never stopped
+	Env           map[string]string
+	PortBindings  map[string][]PortBinding // keyed by container port and protocol, e.g. 80/tcp
+	Ports         []string                 // host:container, e.g. 8080:80, derived from PortBindings
+	Volumes       []string
+	RestartPolicy string
+	Labels        map[string]string
+	Networks      map[string]ContainerNetwork // keyed by network name
+	MemoryLimit   int64                       // bytes, 0 when unlimited
+	NanoCPUs      int64                       // CPU quota in units of 1e-9 CPUs, 0 when unlimited
+	CPUShares     int64                       // relative CPU weight, 0 when unset
+	User          string                      // user the process runs as, empty for the image default (usually root)
+	Privileged    bool
+	// HasHealthcheck is true when the container or its image defines a health check
+	HasHealthcheck bool
+	Health         *HealthState // nil without a health check
+	Mounts         []MountPoint
+}
+
+// NetworkNames returns the names of the networks the container is connected to, sorted
+func (d *ContainerDetail) NetworkNames() []string {
+	names := make([]string, 0, len(d.Networks))
+	for name := range d.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PortBinding is a host address a container port is published on
+type PortBinding struct {
+	HostIP   string
+	HostPort string
+}
+
+// ContainerNetwork is the endpoint of a container on one network
+type ContainerNetwork struct {
+	NetworkID string
+	IPAddress string
+	Gateway   string
+	Aliases   []string
+}
+
+// HealthState is the current result of a container's health check
+type HealthState struct {
+	Status        string // starting, healthy or unhealthy
+	FailingStreak int
+}
+
+// MountPoint is a volume or bind mount of a container
+type MountPoint struct {
+	Type        string // bind, volume or tmpfs
+	Name        string // volume name, empty for bind mounts
+	Source      string
+	Destination string
+	ReadOnly    bool
+}
+
+// ContainerEvent is a state change reported by the Docker daemon (start, die, oom, health_status, ...)
+type ContainerEvent struct {
+	Type       string
+	Action     string
+	Time       time.Time
+	Attributes map[string]string
+}
+
+type LogOptions struct {
+	Follow     bool
+	Tail       string // number of lines from the end, or "all"
+	Since      string // timestamp or relative duration (e.g. 10m)
+	Timestamps bool
+}
+
+type ContainerStats struct {
+	CPUPercent    float64
+	MemoryUsage   uint64
+	MemoryLimit   uint64
+	MemoryPercent float64
+	NetworkRx     uint64
+	NetworkTx     uint64
+}
+
+// NetworkCounters are cumulative network traffic counters of a container
+type NetworkCounters struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+}
+
+// HealthCheckResult is one health check attempt recorded by Docker
+type HealthCheckResult struct {
+	Start    time.Time
+	End      time.Time
+	ExitCode int
+	Output   string
+}
+
+type NetworkCreateOptions struct {
+	Driver      string
+	Labels      map[string]string
+	EnableIPv6  bool
+	IPv6Subnet  string // e.g. fd00:1:2:3:4::/80
+	IPv6Gateway string
+	// Internal networks have no route outside the host
+	Internal bool
+	// Options are driver options, e.g. com.docker.network.bridge.enable_ip_masquerade
+	Options map[string]string
+}
+
 type NetworkInfo struct {
 	ID      string            `json:"id"`
 	Name    string            `json:"name"`
@@ -25,5 +151,14 @@ type NetworkInfo struct {
 	Subnet  string            `json:"subnet"`
 	Gateway string            `json:"gateway"`
 	Labels  map[string]string `json:"labels"`
+	// ContainerCount is the number of containers attached to the network
+	ContainerCount int `json:"container_count"`
+	// Containers is only populated by GetNetworkInfo
+	Containers []NetworkContainer `json:"containers,omitempty"`
 }
 
+// NetworkContainer is a container attached to a network
+type NetworkContainer struct {
+	Name string `json:"name"`
+	IPv4 string `json:"ipv4"`
+}
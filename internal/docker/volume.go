@@ -0,0 +1,14 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+)
+
+// RemoveVolume removes a named volume. It fails if a container still uses the volume.
+func (c *Client) RemoveVolume(ctx context.Context, name string) error {
+	if err := c.cli.VolumeRemove(ctx, name, false); err != nil {
+		return fmt.Errorf("failed to remove volume %s: %w", name, err)
+	}
+	return nil
+}
@@ -46,9 +46,8 @@ type WizardState struct {
 	Quitting bool
 }
 
-// NewWizardState returns initial wizard state with all requirements loaded.
-func NewWizardState() WizardState {
-	reqs := requirements.AllRequirements()
+// NewWizardState returns initial wizard state for the given requirements.
+func NewWizardState(reqs []requirements.Requirement) WizardState {
 	return WizardState{
 		Stage:        StageWelcome,
 		Requirements: reqs,
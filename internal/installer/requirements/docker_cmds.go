@@ -0,0 +1,74 @@
+package requirements
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CheckResultMsg is the aggregate result of checking the Docker requirement.
+type CheckResultMsg struct {
+	DockerOK bool
+	Docker   Result
+	Err      error
+}
+
+// VerifyResultMsg is the result of verifying dependencies (e.g. Docker daemon ping).
+type VerifyResultMsg struct {
+	DockerOK bool
+	Err      error
+}
+
+// InstallDoneMsg signals that the install step has finished.
+type InstallDoneMsg struct {
+	Installed bool // true if we ran an installer, false if already present or skipped
+	Err       error
+}
+
+// CheckDocker returns a Bubble Tea Cmd that runs the full Docker check (CLI + daemon) and sends CheckResultMsg.
+func CheckDocker() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		req, _ := NewDockerRequirement()
+		defer req.Close()
+		res := req.Check(ctx)
+		return CheckResultMsg{
+			DockerOK: res.OK,
+			Docker:   res,
+			Err:      res.Err,
+		}
+	}
+}
+
+// VerifyDocker returns a Bubble Tea Cmd that pings the Docker daemon and sends VerifyResultMsg.
+func VerifyDocker() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		req, _ := NewDockerRequirement()
+		defer req.Close()
+		if err := req.Verify(ctx); err != nil {
+			return VerifyResultMsg{DockerOK: false, Err: err}
+		}
+		return VerifyResultMsg{DockerOK: true}
+	}
+}
+
+// RunInstallStep returns a Bubble Tea Cmd for the install step. If dockerOK is true
+// it immediately returns InstallDoneMsg{Installed: false}. Otherwise it delegates to
+// DockerRequirement.Install, which only supports Linux.
+func RunInstallStep(dockerOK bool) tea.Cmd {
+	return func() tea.Msg {
+		if dockerOK {
+			return InstallDoneMsg{Installed: false}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+		req, _ := NewDockerRequirement()
+		defer req.Close()
+		installed, err := req.Install(ctx)
+		return InstallDoneMsg{Installed: installed, Err: err}
+	}
+}
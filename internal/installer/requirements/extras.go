@@ -0,0 +1,133 @@
+package requirements
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// ExtraRequirements returns example requirements that are not checked by default.
+// They are only offered when asked for by name, e.g. 'finks install --only git'.
+func ExtraRequirements() []Requirement {
+	return []Requirement{NewGitRequirement(), NewCurlRequirement()}
+}
+
+// binaryRequirement is a requirement satisfied by an executable in PATH that can be
+// installed with the system package manager.
+type binaryRequirement struct {
+	name    string
+	binary  string
+	pkg     string
+	docsURL string
+}
+
+// GitRequirement checks that git is installed.
+type GitRequirement struct {
+	binaryRequirement
+}
+
+// NewGitRequirement creates a new git requirement checker.
+func NewGitRequirement() *GitRequirement {
+	return &GitRequirement{binaryRequirement{
+		name:    "Git",
+		binary:  "git",
+		pkg:     "git",
+		docsURL: "https://git-scm.com/downloads",
+	}}
+}
+
+// CurlRequirement checks that curl is installed.
+type CurlRequirement struct {
+	binaryRequirement
+}
+
+// NewCurlRequirement creates a new curl requirement checker.
+func NewCurlRequirement() *CurlRequirement {
+	return &CurlRequirement{binaryRequirement{
+		name:    "curl",
+		binary:  "curl",
+		pkg:     "curl",
+		docsURL: "https://curl.se/download.html",
+	}}
+}
+
+// Name returns the requirement name.
+func (b *binaryRequirement) Name() string {
+	return b.name
+}
+
+// Check verifies the binary is in PATH.
+func (b *binaryRequirement) Check(ctx context.Context) Result {
+	if _, err := exec.LookPath(b.binary); err != nil {
+		return Result{
+			Name:    b.name,
+			OK:      false,
+			Message: fmt.Sprintf("%s not found in PATH", b.binary),
+			Err:     err,
+		}
+	}
+	return Result{Name: b.name, OK: true, Message: fmt.Sprintf("%s found", b.binary)}
+}
+
+// InstallCommand returns the package manager command, or a download URL when none is known.
+func (b *binaryRequirement) InstallCommand() string {
+	if cmd := packageInstallCommand(b.pkg); cmd != "" {
+		return cmd
+	}
+	return b.docsURL
+}
+
+// CanAutoInstall returns true on Linux systems with a known package manager.
+func (b *binaryRequirement) CanAutoInstall() bool {
+	return packageInstallCommand(b.pkg) != ""
+}
+
+// Install runs the system package manager.
+func (b *binaryRequirement) Install(ctx context.Context) (installed bool, err error) {
+	cmdline := packageInstallCommand(b.pkg)
+	if cmdline == "" {
+		return false, fmt.Errorf("automatic %s install is not supported on this system; see %s", b.name, b.docsURL)
+	}
+	cmd := exec.CommandContext(ctx, "sh", "-c", cmdline)
+	if err := cmd.Run(); err != nil {
+		return false, fmt.Errorf("%s install failed: %w", b.name, err)
+	}
+	return true, nil
+}
+
+// Verify confirms the binary is in PATH after installation.
+func (b *binaryRequirement) Verify(ctx context.Context) error {
+	if _, err := exec.LookPath(b.binary); err != nil {
+		return fmt.Errorf("%s not found in PATH", b.binary)
+	}
+	return nil
+}
+
+// Close releases resources used by the requirement.
+func (b *binaryRequirement) Close() error {
+	return nil
+}
+
+// packageInstallCommand returns the command that installs pkg with the Linux package
+// manager found on this system, or "" if there is none.
+func packageInstallCommand(pkg string) string {
+	if runtime.GOOS != "linux" {
+		return ""
+	}
+	managers := []struct {
+		binary string
+		format string
+	}{
+		{"apt-get", "apt-get update && apt-get install -y %s"},
+		{"dnf", "dnf install -y %s"},
+		{"yum", "yum install -y %s"},
+		{"apk", "apk add --no-cache %s"},
+	}
+	for _, m := range managers {
+		if _, err := exec.LookPath(m.binary); err == nil {
+			return fmt.Sprintf(m.format, pkg)
+		}
+	}
+	return ""
+}
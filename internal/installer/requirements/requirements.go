@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/bimalpaudels/finks/internal/docker"
@@ -97,6 +99,19 @@ func (d *DockerRequirement) Check(ctx context.Context) Result {
 	return Result{Name: "Docker", OK: true, Message: "Docker CLI and daemon ready"}
 }
 
+// CheckCLIOnly verifies only that the Docker CLI binary is present (no daemon).
+func (d *DockerRequirement) CheckCLIOnly() Result {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return Result{
+			Name:    "Docker",
+			OK:      false,
+			Message: "Docker CLI not found in PATH",
+			Err:     err,
+		}
+	}
+	return Result{Name: "Docker", OK: true, Message: "Docker CLI found"}
+}
+
 // InstallCommand returns the command used to install Docker.
 func (d *DockerRequirement) InstallCommand() string {
 	if runtime.GOOS == "linux" {
@@ -142,13 +157,54 @@ func (d *DockerRequirement) Close() error {
 	return nil
 }
 
-// AllRequirements returns the ordered list of all requirements to check and install.
-// Add new requirements here to include them in the installation wizard.
+// registered holds requirements added with Register, checked after Docker.
+var registered []Requirement
+
+// Register adds a requirement to the installation wizard. It must be called before
+// the wizard starts, typically from an init function.
+func Register(r Requirement) {
+	registered = append(registered, r)
+}
+
+// AllRequirements returns the ordered list of all requirements to check and install:
+// Docker first, then registered requirements in registration order.
 func AllRequirements() []Requirement {
 	docker, _ := NewDockerRequirement()
-	return []Requirement{
-		docker,
+	return append([]Requirement{docker}, registered...)
+}
+
+// Filter keeps the requirements whose names are listed, matched case-insensitively.
+// The rest are closed. An empty list keeps every requirement.
+func Filter(reqs []Requirement, names []string) ([]Requirement, error) {
+	if len(names) == 0 {
+		return reqs, nil
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+
+	var kept []Requirement
+	for _, req := range reqs {
+		name := strings.ToLower(req.Name())
+		if wanted[name] {
+			kept = append(kept, req)
+			delete(wanted, name)
+			continue
+		}
+		req.Close()
+	}
+
+	if len(wanted) > 0 {
+		var unknown []string
+		for name := range wanted {
+			unknown = append(unknown, name)
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown requirement(s): %s", strings.Join(unknown, ", "))
 	}
+	return kept, nil
 }
 
 // CheckRequirement is a Bubble Tea message for the result of checking a requirement.
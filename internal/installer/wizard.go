@@ -9,9 +9,19 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// Run starts the installation wizard.
-func Run() error {
-	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+// Run starts the installation wizard. If only is given, just the requirements with
+// those names are checked and installed; the extra requirements can be named too.
+func Run(only ...string) error {
+	reqs := requirements.AllRequirements()
+	if len(only) > 0 {
+		reqs = append(reqs, requirements.ExtraRequirements()...)
+	}
+	reqs, err := requirements.Filter(reqs, only)
+	if err != nil {
+		return err
+	}
+
+	p := tea.NewProgram(newModel(reqs), tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		return fmt.Errorf("failed to run wizard: %w", err)
 	}
@@ -24,9 +34,9 @@ type model struct {
 }
 
 // newModel creates a new wizard model.
-func newModel() model {
+func newModel(reqs []requirements.Requirement) model {
 	return model{
-		state: NewWizardState(),
+		state: NewWizardState(reqs),
 	}
 }
 
@@ -0,0 +1,162 @@
+package monitor
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	LevelTest     = "test"
+	LevelWarning  = "warning"
+	LevelCritical = "critical"
+)
+
+const (
+	ResourceCPU    = "cpu"
+	ResourceMemory = "memory"
+	ResourceDisk   = "disk"
+)
+
+// Alert describes a threshold breach
+type Alert struct {
+	Level     string  `json:"level"`
+	Resource  string  `json:"resource"`
+	Value     float64 `json:"value"`
+	Threshold float64 `json:"threshold"`
+	// Mountpoint is set for disk alerts on a partition configured with its own threshold
+	Mountpoint string    `json:"mountpoint,omitempty"`
+	Message    string    `json:"message"`
+	Hostname   string    `json:"hostname"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// Subject names what the alert is about, including the mountpoint of partition alerts
+func (a Alert) Subject() string {
+	if a.Mountpoint != "" {
+		return a.Resource + " " + a.Mountpoint
+	}
+	return a.Resource
+}
+
+// Alerter delivers alerts to an external channel
+type Alerter interface {
+	Send(alert Alert) error
+}
+
+// AlertConfig holds the usage thresholds (in percent) that trigger alerts.
+// A threshold of 0 disables the check.
+type AlertConfig struct {
+	CPUThreshold    float64
+	MemoryThreshold float64
+	// DiskThreshold applies to the root partition
+	DiskThreshold float64
+	// DiskAlerts maps a mountpoint to its own threshold. Crossing it is always critical.
+	// An entry for / takes the place of DiskThreshold.
+	DiskAlerts map[string]float64
+}
+
+// CheckAlerts returns an alert for every resource at or above its threshold.
+// Usage at or above the midpoint between the threshold and 100% is critical.
+func CheckAlerts(m *SystemMetrics, config AlertConfig) []Alert {
+	var alerts []Alert
+
+	check := func(resource string, value, threshold float64, label string) {
+		if threshold <= 0 || value < threshold {
+			return
+		}
+		level := LevelWarning
+		if value >= threshold+(100-threshold)/2 {
+			level = LevelCritical
+		}
+		alerts = append(alerts, Alert{
+			Level:     level,
+			Resource:  resource,
+			Value:     value,
+			Threshold: threshold,
+			Message:   fmt.Sprintf("%s usage is %.1f%% (threshold %.0f%%)", label, value, threshold),
+			Hostname:  m.Hostname,
+			Timestamp: m.Timestamp,
+		})
+	}
+
+	check(ResourceCPU, m.CPU.UsagePercent, config.CPUThreshold, "CPU")
+	check(ResourceMemory, m.Memory.UsedPercent, config.MemoryThreshold, "Memory")
+	for _, p := range m.Disk.Partitions {
+		if threshold, ok := config.DiskAlerts[p.Mountpoint]; ok {
+			if threshold > 0 && p.UsedPercent >= threshold {
+				alerts = append(alerts, Alert{
+					Level:      LevelCritical,
+					Resource:   ResourceDisk,
+					Value:      p.UsedPercent,
+					Threshold:  threshold,
+					Mountpoint: p.Mountpoint,
+					Message:    fmt.Sprintf("Disk usage of %s is %.1f%% (threshold %.0f%%)", p.Mountpoint, p.UsedPercent, threshold),
+					Hostname:   m.Hostname,
+					Timestamp:  m.Timestamp,
+				})
+			}
+			continue
+		}
+		if p.Mountpoint == "/" {
+			check(ResourceDisk, p.UsedPercent, config.DiskThreshold, "Disk")
+		}
+	}
+
+	return alerts
+}
+
+// AlertTracker suppresses repeated alerts while a resource stays above its threshold
+// and keeps track of each breach until the resource recovers
+type AlertTracker struct {
+	active map[string]*Breach
+	ended  []Breach
+}
+
+func NewAlertTracker() *AlertTracker {
+	return &AlertTracker{active: make(map[string]*Breach)}
+}
+
+// Fired returns the alerts for resources that have just crossed their threshold
+// and ends the breaches of resources that have recovered
+func (t *AlertTracker) Fired(alerts []Alert) []Alert {
+	current := make(map[string]*Breach, len(alerts))
+	var fired []Alert
+	for _, alert := range alerts {
+		breach, ok := t.active[alert.Subject()]
+		if !ok {
+			fired = append(fired, alert)
+			breach = newBreach(alert)
+		}
+		breach.observe(alert)
+		current[alert.Subject()] = breach
+	}
+
+	now := time.Now()
+	for subject, breach := range t.active {
+		if _, ok := current[subject]; !ok {
+			breach.End = now
+			t.ended = append(t.ended, *breach)
+		}
+	}
+	t.active = current
+	return fired
+}
+
+// Ended returns the breaches that ended since the last call
+func (t *AlertTracker) Ended() []Breach {
+	ended := t.ended
+	t.ended = nil
+	return ended
+}
+
+// Finish ends all ongoing breaches now, e.g. when monitoring stops, and returns
+// them along with any breaches not yet collected with Ended
+func (t *AlertTracker) Finish() []Breach {
+	now := time.Now()
+	for _, breach := range t.active {
+		breach.End = now
+		t.ended = append(t.ended, *breach)
+	}
+	t.active = make(map[string]*Breach)
+	return t.Ended()
+}
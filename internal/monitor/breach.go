@@ -0,0 +1,125 @@
+package monitor
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Breach is a period during which a resource stayed at or above its alert threshold
+type Breach struct {
+	Resource   string    `json:"resource"`
+	Mountpoint string    `json:"mountpoint,omitempty"`
+	Level      string    `json:"level"`
+	Threshold  float64   `json:"threshold"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Peak       float64   `json:"peak"`
+	PeakAt     time.Time `json:"peak_at"`
+	Hostname   string    `json:"hostname"`
+}
+
+func newBreach(alert Alert) *Breach {
+	return &Breach{
+		Resource:   alert.Resource,
+		Mountpoint: alert.Mountpoint,
+		Level:      alert.Level,
+		Threshold:  alert.Threshold,
+		Start:      alert.Timestamp,
+		Hostname:   alert.Hostname,
+	}
+}
+
+// observe updates the breach with a later alert for the same resource
+func (b *Breach) observe(alert Alert) {
+	if alert.Value > b.Peak {
+		b.Peak, b.PeakAt = alert.Value, alert.Timestamp
+	}
+	if alert.Level == LevelCritical {
+		b.Level = LevelCritical
+	}
+}
+
+// Subject names what the breach is about, like Alert.Subject
+func (b Breach) Subject() string {
+	return Alert{Resource: b.Resource, Mountpoint: b.Mountpoint}.Subject()
+}
+
+// Duration is how long the resource stayed above its threshold
+func (b Breach) Duration() time.Duration {
+	return b.End.Sub(b.Start)
+}
+
+// AlertSummary aggregates the alerts and breaches of one resource
+type AlertSummary struct {
+	Subject string
+	// Breaches counts the alerts fired, including breaches that are still ongoing
+	Breaches int
+	// Longest and Average are computed from breaches that have ended
+	Longest time.Duration
+	Average time.Duration
+	Total   time.Duration
+	Peak    float64
+	PeakAt  time.Time
+}
+
+// SummarizeAlerts groups alerts and ended breaches by resource. The result is ordered
+// from the most to the least problematic resource: by number of breaches, then by
+// total time spent above the threshold.
+func SummarizeAlerts(alerts []Alert, breaches []Breach) []AlertSummary {
+	bySubject := make(map[string]*AlertSummary)
+	get := func(subject string) *AlertSummary {
+		summary, ok := bySubject[subject]
+		if !ok {
+			summary = &AlertSummary{Subject: subject}
+			bySubject[subject] = summary
+		}
+		return summary
+	}
+	peak := func(summary *AlertSummary, value float64, at time.Time) {
+		if value > summary.Peak {
+			summary.Peak, summary.PeakAt = value, at
+		}
+	}
+
+	for _, alert := range alerts {
+		if alert.Level == LevelTest {
+			continue
+		}
+		summary := get(alert.Subject())
+		summary.Breaches++
+		peak(summary, alert.Value, alert.Timestamp)
+	}
+
+	ended := make(map[string]int)
+	for _, breach := range breaches {
+		summary := get(breach.Subject())
+		d := breach.Duration()
+		summary.Total += d
+		summary.Longest = max(summary.Longest, d)
+		ended[breach.Subject()]++
+		peak(summary, breach.Peak, breach.PeakAt)
+	}
+
+	summaries := make([]AlertSummary, 0, len(bySubject))
+	for subject, summary := range bySubject {
+		if n := ended[subject]; n > 0 {
+			summary.Average = summary.Total / time.Duration(n)
+		}
+		// Breaches recorded without a fired alert still count, e.g. when the alert
+		// fired before the summarized period started
+		summary.Breaches = max(summary.Breaches, ended[subject])
+		summaries = append(summaries, *summary)
+	}
+
+	slices.SortFunc(summaries, func(a, b AlertSummary) int {
+		if c := cmp.Compare(b.Breaches, a.Breaches); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Subject, b.Subject)
+	})
+	return summaries
+}
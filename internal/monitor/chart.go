@@ -0,0 +1,240 @@
+package monitor
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+)
+
+// Chart layout in pixels. The percentage panel sits above the network panel and
+// each panel has its legend to the right of the plot area.
+const (
+	chartWidth    = 980
+	chartHeight   = 620
+	plotLeft      = 80
+	plotRight     = 700
+	legendLeft    = 720
+	percentTop    = 70
+	percentBottom = 300
+	networkTop    = 380
+	networkBottom = 570
+
+	horizontalGridLines = 4
+	verticalGridLines   = 6
+)
+
+// chartSeries is one line of a chart panel
+type chartSeries struct {
+	label  string
+	color  string
+	values []float64
+}
+
+type svgDocument struct {
+	XMLName    xml.Name `xml:"svg"`
+	Xmlns      string   `xml:"xmlns,attr"`
+	Width      int      `xml:"width,attr"`
+	Height     int      `xml:"height,attr"`
+	ViewBox    string   `xml:"viewBox,attr"`
+	FontFamily string   `xml:"font-family,attr"`
+	Elements   []any
+}
+
+type svgRect struct {
+	XMLName xml.Name `xml:"rect"`
+	X       float64  `xml:"x,attr"`
+	Y       float64  `xml:"y,attr"`
+	Width   float64  `xml:"width,attr"`
+	Height  float64  `xml:"height,attr"`
+	Fill    string   `xml:"fill,attr"`
+}
+
+type svgLine struct {
+	XMLName   xml.Name `xml:"line"`
+	X1        float64  `xml:"x1,attr"`
+	Y1        float64  `xml:"y1,attr"`
+	X2        float64  `xml:"x2,attr"`
+	Y2        float64  `xml:"y2,attr"`
+	Stroke    string   `xml:"stroke,attr"`
+	DashArray string   `xml:"stroke-dasharray,attr,omitempty"`
+}
+
+type svgPath struct {
+	XMLName     xml.Name `xml:"path"`
+	D           string   `xml:"d,attr"`
+	Stroke      string   `xml:"stroke,attr"`
+	StrokeWidth float64  `xml:"stroke-width,attr"`
+	Fill        string   `xml:"fill,attr"`
+}
+
+type svgCircle struct {
+	XMLName xml.Name `xml:"circle"`
+	CX      float64  `xml:"cx,attr"`
+	CY      float64  `xml:"cy,attr"`
+	R       float64  `xml:"r,attr"`
+	Fill    string   `xml:"fill,attr"`
+}
+
+type svgText struct {
+	XMLName  xml.Name `xml:"text"`
+	X        float64  `xml:"x,attr"`
+	Y        float64  `xml:"y,attr"`
+	FontSize int      `xml:"font-size,attr"`
+	Fill     string   `xml:"fill,attr"`
+	Anchor   string   `xml:"text-anchor,attr,omitempty"`
+	Text     string   `xml:",chardata"`
+}
+
+// ChartsDir is where ExportChart writes charts by default
+func ChartsDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".finks", "charts"), nil
+}
+
+// ExportChart renders samples as an SVG chart and writes it to metrics_<timestamp>.svg
+// in dir, returning the path of the file
+func ExportChart(dir string, samples []MetricsSample) (string, error) {
+	data, err := RenderChart(samples)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create chart directory: %w", err)
+	}
+	path := filepath.Join(dir, fmt.Sprintf("metrics_%s.svg", samples[len(samples)-1].Timestamp.Format("20060102-150405")))
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write chart: %w", err)
+	}
+	return path, nil
+}
+
+// RenderChart draws CPU, memory and root disk usage and network throughput over
+// the time covered by samples, which must be ordered oldest first
+func RenderChart(samples []MetricsSample) ([]byte, error) {
+	if len(samples) < 2 {
+		return nil, fmt.Errorf("need at least 2 recorded samples to draw a chart, have %d", len(samples))
+	}
+	start, end := samples[0].Timestamp, samples[len(samples)-1].Timestamp
+	if !end.After(start) {
+		return nil, fmt.Errorf("recorded samples do not span any time")
+	}
+
+	cpu := chartSeries{label: "CPU", color: "#e4572e"}
+	memory := chartSeries{label: "Memory", color: "#4c78a8"}
+	disk := chartSeries{label: "Disk /", color: "#54a24b"}
+	recv := chartSeries{label: "Received", color: "#b279a2"}
+	sent := chartSeries{label: "Sent", color: "#f2a900"}
+	times := make([]time.Time, len(samples))
+	for i, s := range samples {
+		times[i] = s.Timestamp
+		cpu.values = append(cpu.values, s.CPUPercent)
+		memory.values = append(memory.values, s.MemoryPercent)
+		disk.values = append(disk.values, s.DiskPercent)
+		recv.values = append(recv.values, s.RecvPerSec)
+		sent.values = append(sent.values, s.SentPerSec)
+	}
+
+	doc := svgDocument{
+		Xmlns:      "http://www.w3.org/2000/svg",
+		Width:      chartWidth,
+		Height:     chartHeight,
+		ViewBox:    fmt.Sprintf("0 0 %d %d", chartWidth, chartHeight),
+		FontFamily: "sans-serif",
+	}
+	doc.Elements = append(doc.Elements,
+		svgRect{Width: chartWidth, Height: chartHeight, Fill: "#ffffff"},
+		svgText{X: plotLeft, Y: 32, FontSize: 18, Fill: "#222222",
+			Text: fmt.Sprintf("Server metrics %s – %s", start.Local().Format("2006-01-02 15:04"), end.Local().Format("15:04"))},
+	)
+
+	formatPercent := func(v float64) string { return fmt.Sprintf("%.1f%%", v) }
+	formatRate := func(v float64) string { return units.BytesSize(v) + "/s" }
+
+	networkMax := 1.0
+	for _, v := range append(append([]float64{}, recv.values...), sent.values...) {
+		networkMax = max(networkMax, v)
+	}
+	networkMax *= 1.1
+
+	doc.Elements = append(doc.Elements, chartPanel("Usage", times, percentTop, percentBottom, 100, formatPercent, cpu, memory, disk)...)
+	doc.Elements = append(doc.Elements, chartPanel("Network", times, networkTop, networkBottom, networkMax, formatRate, recv, sent)...)
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode chart: %w", err)
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// chartPanel draws the grid, lines, peak markers and legend of one panel whose
+// y axis runs from 0 to maxValue
+func chartPanel(title string, times []time.Time, top, bottom, maxValue float64, format func(float64) string, series ...chartSeries) []any {
+	start, end := times[0], times[len(times)-1]
+	x := func(t time.Time) float64 {
+		return plotLeft + float64(t.Sub(start))/float64(end.Sub(start))*(plotRight-plotLeft)
+	}
+	y := func(v float64) float64 {
+		return bottom - min(max(v, 0), maxValue)/maxValue*(bottom-top)
+	}
+
+	elements := []any{
+		svgText{X: plotLeft, Y: top - 12, FontSize: 14, Fill: "#222222", Text: title},
+	}
+
+	for i := 0; i <= horizontalGridLines; i++ {
+		v := maxValue * float64(i) / horizontalGridLines
+		elements = append(elements,
+			svgLine{X1: plotLeft, Y1: y(v), X2: plotRight, Y2: y(v), Stroke: "#dddddd", DashArray: "4 4"},
+			svgText{X: plotLeft - 8, Y: y(v) + 4, FontSize: 11, Fill: "#666666", Anchor: "end", Text: format(v)},
+		)
+	}
+	for i := 0; i <= verticalGridLines; i++ {
+		t := start.Add(end.Sub(start) * time.Duration(i) / verticalGridLines)
+		elements = append(elements,
+			svgLine{X1: x(t), Y1: top, X2: x(t), Y2: bottom, Stroke: "#eeeeee"},
+			svgText{X: x(t), Y: bottom + 16, FontSize: 11, Fill: "#666666", Anchor: "middle", Text: t.Local().Format("15:04")},
+		)
+	}
+	elements = append(elements, svgLine{X1: plotLeft, Y1: bottom, X2: plotRight, Y2: bottom, Stroke: "#999999"})
+
+	for i, s := range series {
+		var d strings.Builder
+		peak, low := 0, 0
+		for j, v := range s.values {
+			command := "L"
+			if j == 0 {
+				command = "M"
+			}
+			fmt.Fprintf(&d, "%s%.1f %.1f ", command, x(times[j]), y(v))
+			if v > s.values[peak] {
+				peak = j
+			}
+			if v < s.values[low] {
+				low = j
+			}
+		}
+
+		legendY := top + 16 + float64(i)*36
+		elements = append(elements,
+			svgPath{D: strings.TrimSpace(d.String()), Stroke: s.color, StrokeWidth: 1.5, Fill: "none"},
+			svgCircle{CX: x(times[peak]), CY: y(s.values[peak]), R: 3, Fill: s.color},
+			svgText{X: x(times[peak]), Y: y(s.values[peak]) - 6, FontSize: 10, Fill: s.color, Anchor: "middle",
+				Text: "max " + format(s.values[peak])},
+			svgRect{X: legendLeft, Y: legendY - 10, Width: 12, Height: 12, Fill: s.color},
+			svgText{X: legendLeft + 18, Y: legendY, FontSize: 12, Fill: "#222222", Text: s.label},
+			svgText{X: legendLeft + 18, Y: legendY + 15, FontSize: 11, Fill: "#666666",
+				Text: fmt.Sprintf("min %s · max %s", format(s.values[low]), format(s.values[peak]))},
+		)
+	}
+
+	return elements
+}
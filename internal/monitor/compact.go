@@ -0,0 +1,98 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/mattn/go-runewidth"
+)
+
+// CompactOptions controls the single-line monitor output
+type CompactOptions struct {
+	// Separator is placed between the fields, e.g. " | "
+	Separator string
+	// Color highlights usage percentages
+	Color bool
+	// Overwrite rewrites the same terminal line with \r instead of printing a line per sample
+	Overwrite bool
+	// Thresholds are the color transition points; the zero value uses DefaultColorThresholds
+	Thresholds ColorThresholds
+}
+
+// RenderCompact formats the headline metrics as one line
+func RenderCompact(m *SystemMetrics, opts CompactOptions) string {
+	thresholds := opts.Thresholds
+	if thresholds == (ColorThresholds{}) {
+		thresholds = DefaultColorThresholds
+	}
+	percent := func(p float64) string {
+		text := fmt.Sprintf("%.0f%%", p)
+		if opts.Color {
+			return percentStyle(p, thresholds).Render(text)
+		}
+		return text
+	}
+
+	fields := []string{
+		"CPU: " + percent(m.CPU.UsagePercent),
+		fmt.Sprintf("MEM: %s/%s (%s)", units.BytesSize(float64(m.Memory.Used)), units.BytesSize(float64(m.Memory.Total)), percent(m.Memory.UsedPercent)),
+	}
+	for _, p := range m.Disk.Partitions {
+		if p.Mountpoint == "/" {
+			fields = append(fields, fmt.Sprintf("DISK: %s/%s (%s)", units.BytesSize(float64(p.Used)), units.BytesSize(float64(p.Total)), percent(p.UsedPercent)))
+		}
+	}
+	fields = append(fields,
+		fmt.Sprintf("LOAD: %.2f %.2f %.2f", m.Load.Load1, m.Load.Load5, m.Load.Load15),
+		fmt.Sprintf("NET: ↓%s/s ↑%s/s", units.BytesSize(m.Network.RecvPerSec), units.BytesSize(m.Network.SentPerSec)),
+	)
+	return strings.Join(fields, opts.Separator)
+}
+
+// RunCompact writes a compact line to w every interval until ctx is cancelled.
+// onMetrics, if set, is called with each sample.
+func RunCompact(ctx context.Context, service *MetricsService, interval time.Duration, onMetrics func(*SystemMetrics), w io.Writer, opts CompactOptions) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastWidth := 0
+	for {
+		var line string
+		metrics, err := service.GetMetrics(ctx)
+		switch {
+		case ctx.Err() != nil:
+			// Interrupted while sampling
+		case err != nil:
+			line = fmt.Sprintf("Failed to collect metrics: %v", err)
+		default:
+			if onMetrics != nil {
+				onMetrics(metrics)
+			}
+			line = RenderCompact(metrics, opts)
+		}
+
+		if line != "" {
+			if opts.Overwrite {
+				// Pad with spaces so a shorter line fully covers the previous one
+				width := runewidth.StringWidth(ansiPattern.ReplaceAllString(line, ""))
+				fmt.Fprintf(w, "\r%s%s", line, strings.Repeat(" ", max(0, lastWidth-width)))
+				lastWidth = width
+			} else {
+				fmt.Fprintln(w, line)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if opts.Overwrite && lastWidth > 0 {
+				fmt.Fprintln(w)
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
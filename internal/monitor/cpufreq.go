@@ -0,0 +1,69 @@
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// cpufreqDir is where Linux exposes the frequency scaling of the first core
+const cpufreqDir = "/sys/devices/system/cpu/cpu0/cpufreq"
+
+// ErrNoCPUFreq is returned when the system does not expose CPU frequency scaling
+var ErrNoCPUFreq = errors.New("CPU frequency scaling is not available on this system")
+
+// readCPUFreq returns the scaling governor of the first core and its current frequency
+// in MHz. Both are empty on systems without cpufreq, such as non-Linux hosts and most VMs.
+func readCPUFreq() (governor string, mhz float64) {
+	if data, err := os.ReadFile(filepath.Join(cpufreqDir, "scaling_governor")); err == nil {
+		governor = strings.TrimSpace(string(data))
+	}
+	if data, err := os.ReadFile(filepath.Join(cpufreqDir, "scaling_cur_freq")); err == nil {
+		if khz, err := strconv.ParseFloat(strings.TrimSpace(string(data)), 64); err == nil {
+			mhz = khz / 1000
+		}
+	}
+	return governor, mhz
+}
+
+// AvailableCPUGovernors lists the scaling governors the kernel offers
+func AvailableCPUGovernors() ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(cpufreqDir, "scaling_available_governors"))
+	if os.IsNotExist(err) {
+		return nil, ErrNoCPUFreq
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read available governors: %w", err)
+	}
+	return strings.Fields(string(data)), nil
+}
+
+// SetCPUGovernor writes governor to the scaling_governor of every core and returns
+// the number of cores changed. Writing requires root.
+func SetCPUGovernor(governor string) (int, error) {
+	available, err := AvailableCPUGovernors()
+	if err != nil {
+		return 0, err
+	}
+	if !slices.Contains(available, governor) {
+		return 0, fmt.Errorf("governor %q is not available (available: %s)", governor, strings.Join(available, ", "))
+	}
+
+	paths, err := filepath.Glob("/sys/devices/system/cpu/cpu[0-9]*/cpufreq/scaling_governor")
+	if err != nil {
+		return 0, fmt.Errorf("failed to find CPU governors: %w", err)
+	}
+	for i, path := range paths {
+		if err := os.WriteFile(path, []byte(governor), 0644); err != nil {
+			if os.IsPermission(err) {
+				return i, fmt.Errorf("permission denied writing %s: run as root", path)
+			}
+			return i, fmt.Errorf("failed to set governor: %w", err)
+		}
+	}
+	return len(paths), nil
+}
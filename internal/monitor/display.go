@@ -0,0 +1,275 @@
+package monitor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/docker/go-units"
+)
+
+const (
+	minBarWidth = 10
+	maxBarWidth = 40
+
+	// twoColumnWidth is the terminal width from which panels are laid out side by side
+	twoColumnWidth = 100
+
+	// userColumnWidth is the width of the USER column in process tables
+	userColumnWidth = 10
+)
+
+// renderMetrics lays out all metric sections for a terminal of the given size.
+// Alerts received from other servers get their own section when there are any.
+func renderMetrics(m *SystemMetrics, received []Alert, width int, thresholds ColorThresholds) string {
+	if width <= 0 {
+		width = 80
+	}
+
+	var sections []string
+	sections = append(sections, renderSystemOverview(m))
+	if watched := renderWatchedProcesses(m.Processes.Watched, width); watched != "" {
+		sections = append(sections, watched)
+	}
+
+	if width >= twoColumnWidth {
+		half := width / 2
+		sections = append(sections,
+			joinPanels(
+				panel("CPU", renderCPUMetrics(m.CPU, m.Load, half, thresholds), half),
+				panel("Memory", renderMemoryMetrics(m.Memory, half, thresholds), width-half),
+			),
+			joinPanels(
+				panel("Disk", renderDiskMetrics(m.Disk, half, thresholds), half),
+				panel("Network", renderNetworkMetrics(m.Network), width-half),
+			),
+			joinPanels(
+				panel("Top CPU", renderProcessTable(m.Processes.TopCPU, half), half),
+				panel("Top Memory", renderProcessTable(m.Processes.TopMemory, half), width-half),
+			),
+		)
+	} else {
+		sections = append(sections,
+			panel("CPU", renderCPUMetrics(m.CPU, m.Load, width, thresholds), width),
+			panel("Memory", renderMemoryMetrics(m.Memory, width, thresholds), width),
+			panel("Disk", renderDiskMetrics(m.Disk, width, thresholds), width),
+			panel("Network", renderNetworkMetrics(m.Network), width),
+			panel("Top CPU", renderProcessTable(m.Processes.TopCPU, width), width),
+			panel("Top Memory", renderProcessTable(m.Processes.TopMemory, width), width),
+		)
+	}
+
+	if len(received) > 0 {
+		sections = append(sections, panel("Received Alerts", renderReceivedAlerts(received, width), width))
+	}
+
+	sections = append(sections, dimStyle.Render(fmt.Sprintf("Updated %s · q to exit", m.Timestamp.Format("15:04:05"))))
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// panel renders a titled, bordered section that is width columns wide overall
+func panel(title, body string, width int) string {
+	frame := sectionStyle.GetHorizontalFrameSize()
+	return sectionStyle.Width(max(width-frame, 0)).Render(titleStyle.Render(title) + "\n" + body)
+}
+
+func joinPanels(left, right string) string {
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, right)
+}
+
+// contentWidth is the usable width inside a panel of the given overall width
+func contentWidth(width int) int {
+	return max(width-sectionStyle.GetHorizontalFrameSize(), 0)
+}
+
+// barWidth sizes usage bars to roughly a third of the panel
+func barWidth(width int) int {
+	return max(minBarWidth, min(maxBarWidth, contentWidth(width)/3))
+}
+
+func renderSystemOverview(m *SystemMetrics) string {
+	overview := fmt.Sprintf("%s  Host: %s   Uptime: %s   Processes: %d",
+		titleStyle.Render("System Overview"), m.Hostname, formatUptime(m.Uptime), m.Processes.Total)
+	if m.CPU.Governor != "" {
+		overview += "   Governor: " + m.CPU.Governor
+		if m.CPU.FrequencyMHz > 0 {
+			overview += fmt.Sprintf(" @ %.2f GHz", m.CPU.FrequencyMHz/1000)
+		}
+	}
+	return overview
+}
+
+func renderCPUMetrics(c CPUMetrics, l LoadMetrics, width int, thresholds ColorThresholds) string {
+	var b strings.Builder
+	if c.ModelName != "" {
+		b.WriteString(truncate(fmt.Sprintf("%s (%d cores)", c.ModelName, c.Cores), contentWidth(width)) + "\n")
+	}
+	fmt.Fprintf(&b, "Usage  %s\n", renderBar(c.UsagePercent, barWidth(width), thresholds))
+	fmt.Fprintf(&b, "Load   %.2f %.2f %.2f", l.Load1, l.Load5, l.Load15)
+
+	if len(c.History) > 0 {
+		for i, pct := range c.PerCore {
+			fmt.Fprintf(&b, "\n  %-3d %s  %s", i, percentStyle(pct, thresholds).Render(fmt.Sprintf("%5.1f%%", pct)), renderSparkline(c.History[i], thresholds))
+		}
+		return b.String()
+	}
+
+	var cores []string
+	for i, pct := range c.PerCore {
+		cores = append(cores, fmt.Sprintf("%d:%s", i, percentStyle(pct, thresholds).Render(fmt.Sprintf("%5.1f%%", pct))))
+	}
+	if len(cores) > 0 {
+		b.WriteString("\nCores  " + strings.Join(cores, "  "))
+	}
+	return b.String()
+}
+
+// sparkLevels are the characters for eight usage levels from 0 to 100%
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// renderSparkline draws one character per sample, colored by its usage
+func renderSparkline(samples []float64, thresholds ColorThresholds) string {
+	var b strings.Builder
+	for _, pct := range samples {
+		level := int(pct / 100 * float64(len(sparkLevels)))
+		level = max(0, min(len(sparkLevels)-1, level))
+		b.WriteString(percentStyle(pct, thresholds).Render(string(sparkLevels[level])))
+	}
+	return b.String()
+}
+
+func renderMemoryMetrics(m MemoryMetrics, width int, thresholds ColorThresholds) string {
+	s := fmt.Sprintf("RAM    %s  %s / %s", renderBar(m.UsedPercent, barWidth(width), thresholds), units.BytesSize(float64(m.Used)), units.BytesSize(float64(m.Total)))
+	if m.SwapTotal > 0 {
+		s += fmt.Sprintf("\nSwap   %s  %s / %s", renderBar(m.SwapPercent, barWidth(width), thresholds), units.BytesSize(float64(m.SwapUsed)), units.BytesSize(float64(m.SwapTotal)))
+	}
+	return s
+}
+
+func renderDiskMetrics(d DiskMetrics, width int, thresholds ColorThresholds) string {
+	var b strings.Builder
+	for _, p := range d.Partitions {
+		fmt.Fprintf(&b, "%-12s %s  %s / %s\n", truncate(p.Mountpoint, 12), renderBar(p.UsedPercent, barWidth(width), thresholds), units.BytesSize(float64(p.Used)), units.BytesSize(float64(p.Total)))
+	}
+	fmt.Fprintf(&b, "I/O    read %s/s   write %s/s", units.BytesSize(d.ReadBytesPerSec), units.BytesSize(d.WriteBytesPerSec))
+	if len(d.IODetail) > 0 {
+		b.WriteString("\nI/O Detail")
+		for _, detail := range d.IODetail {
+			if detail.Device == "" {
+				fmt.Fprintf(&b, "\n  %-12s %s", detail.Mountpoint, dimStyle.Render("not mounted"))
+				continue
+			}
+			fmt.Fprintf(&b, "\n  %-12s %-16s read %s/s   write %s/s", detail.Mountpoint, detail.Device,
+				units.BytesSize(detail.ReadBytesPerSec), units.BytesSize(detail.WriteBytesPerSec))
+		}
+	}
+	return b.String()
+}
+
+func renderNetworkMetrics(n NetworkMetrics) string {
+	return fmt.Sprintf("↓ %s/s (total %s)\n↑ %s/s (total %s)",
+		units.BytesSize(n.RecvPerSec), units.BytesSize(float64(n.BytesRecv)),
+		units.BytesSize(n.SentPerSec), units.BytesSize(float64(n.BytesSent)))
+}
+
+// renderWatchedProcesses shows processes matching --watch-process; it is empty when none are watched
+func renderWatchedProcesses(processes []ProcessInfo, width int) string {
+	if len(processes) == 0 {
+		return ""
+	}
+	return panel(watchedStyle.Render("WATCHED"), renderProcessTable(processes, width), width)
+}
+
+// renderReceivedAlerts lists alerts posted by other servers, newest first
+func renderReceivedAlerts(alerts []Alert, width int) string {
+	hostWidth := max(12, contentWidth(width)-60)
+	rows := []string{fmt.Sprintf("%-8s %-*s %-8s %-16s %7s %9s", "TIME", hostWidth, "HOST", "LEVEL", "RESOURCE", "VALUE", "THRESHOLD")}
+	for _, alert := range alerts {
+		row := fmt.Sprintf("%-8s %-*s %-8s %-16s %6.1f%% %8.0f%%",
+			alert.Timestamp.Local().Format("15:04:05"), hostWidth, truncate(alert.Hostname, hostWidth),
+			alert.Level, truncate(alert.Subject(), 16), alert.Value, alert.Threshold)
+		if alert.Level == LevelCritical {
+			row = errorStyle.Render(row)
+		} else {
+			row = watchedStyle.Render(row)
+		}
+		rows = append(rows, row)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// renderProcessTable renders a process table, giving the NAME column whatever width is left.
+// A USER column is added when process owners were collected.
+func renderProcessTable(processes []ProcessInfo, width int) string {
+	showUser := slices.ContainsFunc(processes, func(p ProcessInfo) bool { return p.Username != "" })
+	nameWidth := max(12, contentWidth(width)-40)
+	if showUser {
+		nameWidth = max(12, nameWidth-userColumnWidth-1)
+	}
+
+	header := fmt.Sprintf("%-8s ", "PID")
+	if showUser {
+		header += fmt.Sprintf("%-*s ", userColumnWidth, "USER")
+	}
+	header += fmt.Sprintf("%-*s %7s %7s %10s", nameWidth, "NAME", "CPU%", "MEM%", "RSS")
+
+	rows := []string{header}
+	for _, proc := range processes {
+		row := fmt.Sprintf("%-8d ", proc.PID)
+		if showUser {
+			row += fmt.Sprintf("%-*s ", userColumnWidth, truncate(proc.Username, userColumnWidth))
+		}
+		row += fmt.Sprintf("%-*s %7.1f %7.1f %10s",
+			nameWidth, truncate(proc.Name, nameWidth), proc.CPUPercent, proc.MemoryPercent, units.BytesSize(float64(proc.MemoryRSS)))
+		if proc.Watched {
+			row = watchedStyle.Render(row)
+		}
+		rows = append(rows, row)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// percentStyle picks a color for a usage percentage
+func percentStyle(percent float64, thresholds ColorThresholds) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(getPercentageColor(percent, thresholds))
+}
+
+// getPercentageColor picks a color for a usage percentage
+func getPercentageColor(percent float64, thresholds ColorThresholds) lipgloss.Color {
+	switch {
+	case percent >= thresholds.High:
+		return colorCritical
+	case percent >= thresholds.Medium:
+		return colorHigh
+	case percent >= thresholds.Low:
+		return colorMedium
+	default:
+		return colorLow
+	}
+}
+
+func renderBar(percent float64, width int, thresholds ColorThresholds) string {
+	filled := int(percent / 100 * float64(width))
+	filled = max(0, min(width, filled))
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+	return percentStyle(percent, thresholds).Render(fmt.Sprintf("%s %5.1f%%", bar, percent))
+}
+
+func formatUptime(d time.Duration) string {
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh %dm", days, hours, minutes)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-1] + "…"
+}
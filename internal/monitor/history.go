@@ -0,0 +1,157 @@
+package monitor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+func alertHistoryPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".finks", "alerts.jsonl"), nil
+}
+
+// HistoryAlerter records every fired alert in ~/.finks/alerts.jsonl
+type HistoryAlerter struct {
+	mu sync.Mutex
+}
+
+func NewHistoryAlerter() *HistoryAlerter {
+	return &HistoryAlerter{}
+}
+
+// Send appends the alert as one JSON line. Each entry is flushed before the file is
+// closed, so a crash never leaves a partial line behind for later entries.
+func (h *HistoryAlerter) Send(alert Alert) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	path, err := alertHistoryPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open alert history: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if err := json.NewEncoder(w).Encode(alert); err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write alert history: %w", err)
+	}
+	return nil
+}
+
+// LoadAlertHistory returns the recorded alerts fired at or after since, newest first
+func LoadAlertHistory(since time.Time) ([]Alert, error) {
+	path, err := alertHistoryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := readJSONLines(path, func(alert Alert) bool { return !alert.Timestamp.Before(since) })
+	if err != nil {
+		return nil, fmt.Errorf("failed to read alert history: %w", err)
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool { return alerts[i].Timestamp.After(alerts[j].Timestamp) })
+	return alerts, nil
+}
+
+func breachHistoryPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".finks", "breaches.jsonl"), nil
+}
+
+// breachMu serializes writes to the breach history
+var breachMu sync.Mutex
+
+// RecordBreach appends an ended breach to ~/.finks/breaches.jsonl
+func RecordBreach(breach Breach) error {
+	breachMu.Lock()
+	defer breachMu.Unlock()
+
+	path, err := breachHistoryPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open breach history: %w", err)
+	}
+	defer f.Close()
+
+	if err := json.NewEncoder(f).Encode(breach); err != nil {
+		return fmt.Errorf("failed to write breach history: %w", err)
+	}
+	return nil
+}
+
+// LoadBreachHistory returns the recorded breaches that ended at or after since, oldest first
+func LoadBreachHistory(since time.Time) ([]Breach, error) {
+	path, err := breachHistoryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	breaches, err := readJSONLines(path, func(breach Breach) bool { return !breach.End.Before(since) })
+	if err != nil {
+		return nil, fmt.Errorf("failed to read breach history: %w", err)
+	}
+
+	sort.SliceStable(breaches, func(i, j int) bool { return breaches[i].End.Before(breaches[j].End) })
+	return breaches, nil
+}
+
+// readJSONLines decodes the JSON lines of a file that keep accepts. A missing file
+// has no entries.
+func readJSONLines[T any](path string, keep func(T) bool) ([]T, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []T
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry T
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// Skip lines that were cut short rather than failing the whole history
+			continue
+		}
+		if keep(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,397 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/host"
+	"github.com/shirou/gopsutil/v4/load"
+	"github.com/shirou/gopsutil/v4/mem"
+	"github.com/shirou/gopsutil/v4/net"
+	"github.com/shirou/gopsutil/v4/process"
+)
+
+// cpuHistorySize is the number of per-core samples kept for sparklines
+const cpuHistorySize = 20
+
+// topProcessCount is the number of processes shown in the top CPU and memory lists
+const topProcessCount = 5
+
+// ignoredFstypes are pseudo filesystems that are not worth reporting as disks
+var ignoredFstypes = map[string]bool{
+	"tmpfs": true, "devtmpfs": true, "overlay": true, "squashfs": true,
+	"proc": true, "sysfs": true, "cgroup": true, "cgroup2": true,
+}
+
+// MetricsService collects system metrics. It keeps the previous sample so that
+// rates (disk I/O, network throughput, process CPU) can be computed between calls.
+type MetricsService struct {
+	opts          MetricsOptions
+	lastSample    time.Time
+	lastDiskRead  uint64
+	lastDiskWrite uint64
+	lastDeviceIO  map[string]disk.IOCountersStat
+	lastNetRecv   uint64
+	lastNetSent   uint64
+	processes     map[int32]*process.Process
+
+	// cpuHistory is a ring buffer of per-core usage, indexed by sample then core
+	cpuHistory     [][]float64
+	cpuHistoryNext int
+}
+
+func NewMetricsService(opts MetricsOptions) *MetricsService {
+	return &MetricsService{
+		opts:         opts,
+		lastDeviceIO: make(map[string]disk.IOCountersStat),
+		processes:    make(map[int32]*process.Process),
+	}
+}
+
+// GetMetrics collects a snapshot of system metrics
+func (s *MetricsService) GetMetrics(ctx context.Context) (*SystemMetrics, error) {
+	now := time.Now()
+	elapsed := now.Sub(s.lastSample).Seconds()
+	if s.lastSample.IsZero() {
+		elapsed = 0
+	}
+
+	metrics := &SystemMetrics{Timestamp: now}
+	metrics.Hostname, _ = os.Hostname()
+
+	if uptime, err := host.UptimeWithContext(ctx); err == nil {
+		metrics.Uptime = time.Duration(uptime) * time.Second
+	}
+
+	var err error
+	if metrics.CPU, err = s.getCPUMetrics(ctx); err != nil {
+		return nil, err
+	}
+	if metrics.Memory, err = s.getMemoryMetrics(ctx); err != nil {
+		return nil, err
+	}
+	if metrics.Disk, err = s.getDiskMetrics(ctx, elapsed); err != nil {
+		return nil, err
+	}
+	if metrics.Network, err = s.getNetworkMetrics(ctx, elapsed); err != nil {
+		return nil, err
+	}
+	if avg, err := load.AvgWithContext(ctx); err == nil {
+		metrics.Load = LoadMetrics{Load1: avg.Load1, Load5: avg.Load5, Load15: avg.Load15}
+	}
+	if metrics.Processes, err = s.getProcessMetrics(ctx, s.opts.WatchPatterns); err != nil {
+		return nil, err
+	}
+
+	s.lastSample = now
+	return metrics, nil
+}
+
+func (s *MetricsService) getCPUMetrics(ctx context.Context) (CPUMetrics, error) {
+	perCore, err := cpu.PercentWithContext(ctx, 0, true)
+	if err != nil {
+		return CPUMetrics{}, fmt.Errorf("failed to get CPU usage: %w", err)
+	}
+
+	var total float64
+	for _, pct := range perCore {
+		total += pct
+	}
+
+	metrics := CPUMetrics{PerCore: perCore, Cores: len(perCore)}
+	if len(perCore) > 0 {
+		metrics.UsagePercent = total / float64(len(perCore))
+	}
+
+	if info, err := cpu.InfoWithContext(ctx); err == nil && len(info) > 0 {
+		metrics.ModelName = strings.TrimSpace(info[0].ModelName)
+	}
+	metrics.Governor, metrics.FrequencyMHz = readCPUFreq()
+
+	if s.opts.PerCPU {
+		metrics.History = s.recordCPUHistory(perCore)
+	}
+
+	return metrics, nil
+}
+
+// recordCPUHistory adds a per-core sample to the ring buffer and returns the
+// buffered samples per core, oldest first
+func (s *MetricsService) recordCPUHistory(perCore []float64) [][]float64 {
+	// Start over if the number of cores changed, e.g. after CPU hotplug
+	if len(s.cpuHistory) > 0 && len(s.cpuHistory[0]) != len(perCore) {
+		s.cpuHistory, s.cpuHistoryNext = nil, 0
+	}
+
+	if len(s.cpuHistory) < cpuHistorySize {
+		s.cpuHistory = append(s.cpuHistory, slices.Clone(perCore))
+	} else {
+		s.cpuHistory[s.cpuHistoryNext] = slices.Clone(perCore)
+		s.cpuHistoryNext = (s.cpuHistoryNext + 1) % cpuHistorySize
+	}
+
+	history := make([][]float64, len(perCore))
+	for i := range s.cpuHistory {
+		sample := s.cpuHistory[(s.cpuHistoryNext+i)%len(s.cpuHistory)]
+		for core, pct := range sample {
+			history[core] = append(history[core], pct)
+		}
+	}
+	return history
+}
+
+func (s *MetricsService) getMemoryMetrics(ctx context.Context) (MemoryMetrics, error) {
+	vm, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return MemoryMetrics{}, fmt.Errorf("failed to get memory usage: %w", err)
+	}
+
+	metrics := MemoryMetrics{
+		Total:       vm.Total,
+		Used:        vm.Used,
+		Available:   vm.Available,
+		UsedPercent: vm.UsedPercent,
+	}
+
+	if swap, err := mem.SwapMemoryWithContext(ctx); err == nil {
+		metrics.SwapTotal = swap.Total
+		metrics.SwapUsed = swap.Used
+		metrics.SwapPercent = swap.UsedPercent
+	}
+
+	return metrics, nil
+}
+
+func (s *MetricsService) getDiskMetrics(ctx context.Context, elapsed float64) (DiskMetrics, error) {
+	partitions, err := disk.PartitionsWithContext(ctx, false)
+	if err != nil {
+		return DiskMetrics{}, fmt.Errorf("failed to list disk partitions: %w", err)
+	}
+
+	var metrics DiskMetrics
+	seen := make(map[string]bool)
+	for _, part := range partitions {
+		if ignoredFstypes[part.Fstype] || seen[part.Device] {
+			continue
+		}
+		usage, err := disk.UsageWithContext(ctx, part.Mountpoint)
+		if err != nil || usage.Total == 0 {
+			continue
+		}
+		seen[part.Device] = true
+		metrics.Partitions = append(metrics.Partitions, PartitionMetrics{
+			Mountpoint:  part.Mountpoint,
+			Device:      part.Device,
+			Fstype:      part.Fstype,
+			Total:       usage.Total,
+			Used:        usage.Used,
+			UsedPercent: usage.UsedPercent,
+		})
+	}
+
+	counters, err := disk.IOCountersWithContext(ctx)
+	if err != nil {
+		return metrics, nil
+	}
+
+	if len(s.opts.DiskPaths) == 0 {
+		var read, write uint64
+		for _, c := range counters {
+			read += c.ReadBytes
+			write += c.WriteBytes
+		}
+		if elapsed > 0 {
+			metrics.ReadBytesPerSec = rate(read, s.lastDiskRead, elapsed)
+			metrics.WriteBytesPerSec = rate(write, s.lastDiskWrite, elapsed)
+		}
+		s.lastDiskRead, s.lastDiskWrite = read, write
+		return metrics, nil
+	}
+
+	// Only report I/O for the devices backing the selected mountpoints
+	for _, path := range s.opts.DiskPaths {
+		detail := DiskIOMetrics{Mountpoint: path}
+		for _, part := range partitions {
+			if part.Mountpoint == path {
+				detail.Device = part.Device
+				break
+			}
+		}
+
+		if counter, ok := findIOCounter(counters, detail.Device); ok {
+			if last, seen := s.lastDeviceIO[counter.Name]; seen && elapsed > 0 {
+				detail.ReadBytesPerSec = rate(counter.ReadBytes, last.ReadBytes, elapsed)
+				detail.WriteBytesPerSec = rate(counter.WriteBytes, last.WriteBytes, elapsed)
+			}
+			s.lastDeviceIO[counter.Name] = counter
+		}
+
+		metrics.ReadBytesPerSec += detail.ReadBytesPerSec
+		metrics.WriteBytesPerSec += detail.WriteBytesPerSec
+		metrics.IODetail = append(metrics.IODetail, detail)
+	}
+
+	return metrics, nil
+}
+
+// findIOCounter returns the I/O counters for a partition device such as /dev/sda1.
+// Counters are keyed by kernel name (sda1, dm-0), so device-mapper volumes are
+// matched by their label instead.
+func findIOCounter(counters map[string]disk.IOCountersStat, device string) (disk.IOCountersStat, bool) {
+	if device == "" {
+		return disk.IOCountersStat{}, false
+	}
+
+	name := filepath.Base(device)
+	if c, ok := counters[name]; ok {
+		return c, true
+	}
+	for _, c := range counters {
+		if c.Label != "" && c.Label == name {
+			return c, true
+		}
+	}
+	return disk.IOCountersStat{}, false
+}
+
+func (s *MetricsService) getNetworkMetrics(ctx context.Context, elapsed float64) (NetworkMetrics, error) {
+	counters, err := net.IOCountersWithContext(ctx, false)
+	if err != nil {
+		return NetworkMetrics{}, fmt.Errorf("failed to get network counters: %w", err)
+	}
+
+	var metrics NetworkMetrics
+	if len(counters) > 0 {
+		metrics.BytesRecv = counters[0].BytesRecv
+		metrics.BytesSent = counters[0].BytesSent
+	}
+
+	if elapsed > 0 {
+		metrics.RecvPerSec = rate(metrics.BytesRecv, s.lastNetRecv, elapsed)
+		metrics.SentPerSec = rate(metrics.BytesSent, s.lastNetSent, elapsed)
+	}
+	s.lastNetRecv, s.lastNetSent = metrics.BytesRecv, metrics.BytesSent
+
+	return metrics, nil
+}
+
+func (s *MetricsService) getProcessMetrics(ctx context.Context, watchPatterns []string) (ProcessMetrics, error) {
+	pids, err := process.PidsWithContext(ctx)
+	if err != nil {
+		return ProcessMetrics{}, fmt.Errorf("failed to list processes: %w", err)
+	}
+
+	// Reuse process handles so CPU percentages are measured since the previous sample
+	current := make(map[int32]*process.Process, len(pids))
+	infos := make([]ProcessInfo, 0, len(pids))
+	var watched []ProcessInfo
+	for _, pid := range pids {
+		p, ok := s.processes[pid]
+		if !ok {
+			if p, err = process.NewProcessWithContext(ctx, pid); err != nil {
+				continue
+			}
+		}
+		current[pid] = p
+
+		name, err := p.NameWithContext(ctx)
+		if err != nil {
+			continue
+		}
+		cpuPercent, _ := p.PercentWithContext(ctx, 0)
+		memPercent, _ := p.MemoryPercentWithContext(ctx)
+
+		info := ProcessInfo{
+			PID:           pid,
+			Name:          name,
+			CPUPercent:    cpuPercent,
+			MemoryPercent: memPercent,
+			Watched:       matchesAny(name, watchPatterns),
+		}
+		if s.opts.Users || s.opts.FilterUser != "" {
+			info.Username = processUsername(ctx, p)
+			if s.opts.FilterUser != "" && info.Username != s.opts.FilterUser && !ownedByUID(ctx, p, s.opts.FilterUser) {
+				continue
+			}
+		}
+		if memInfo, err := p.MemoryInfoWithContext(ctx); err == nil {
+			info.MemoryRSS = memInfo.RSS
+		}
+
+		if info.Watched {
+			watched = append(watched, info)
+		}
+
+		if (info.CPUPercent > 0 || info.MemoryPercent > 0) && s.opts.ProcessFilter.Matches(name) {
+			infos = append(infos, info)
+		}
+	}
+	s.processes = current
+
+	sort.Slice(watched, func(i, j int) bool { return watched[i].CPUPercent > watched[j].CPUPercent })
+	metrics := ProcessMetrics{Total: len(pids), Watched: watched}
+
+	count := s.opts.TopCount
+	if count <= 0 {
+		count = topProcessCount
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].CPUPercent > infos[j].CPUPercent })
+	metrics.TopCPU = append([]ProcessInfo(nil), infos[:min(count, len(infos))]...)
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].MemoryPercent > infos[j].MemoryPercent })
+	metrics.TopMemory = append([]ProcessInfo(nil), infos[:min(count, len(infos))]...)
+
+	return metrics, nil
+}
+
+// GetProcessMetrics collects only the process lists. CPU percentages are measured
+// since the previous call, so the first call reports them as zero.
+func (s *MetricsService) GetProcessMetrics(ctx context.Context) (ProcessMetrics, error) {
+	return s.getProcessMetrics(ctx, s.opts.WatchPatterns)
+}
+
+// processUsername returns the owner of a process, falling back to its UID when
+// the UID has no user name (e.g. in containers without /etc/passwd entries)
+func processUsername(ctx context.Context, p *process.Process) string {
+	if username, err := p.UsernameWithContext(ctx); err == nil && username != "" {
+		return username
+	}
+	if uids, err := p.UidsWithContext(ctx); err == nil && len(uids) > 0 {
+		return strconv.Itoa(int(uids[0]))
+	}
+	return "?"
+}
+
+// ownedByUID reports whether a process's real UID is the given numeric UID
+func ownedByUID(ctx context.Context, p *process.Process, uid string) bool {
+	uids, err := p.UidsWithContext(ctx)
+	return err == nil && len(uids) > 0 && strconv.Itoa(int(uids[0])) == uid
+}
+
+// matchesAny reports whether name matches any of the glob patterns
+func matchesAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// rate returns the per-second rate between two counter samples, guarding against counter resets
+func rate(current, previous uint64, elapsed float64) float64 {
+	if current < previous || elapsed <= 0 {
+		return 0
+	}
+	return float64(current-previous) / elapsed
+}
@@ -0,0 +1,130 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// metricsMsg carries a freshly collected metrics sample
+type metricsMsg struct {
+	metrics *SystemMetrics
+	err     error
+}
+
+// Model is the Bubble Tea model behind `finks server monitor`
+type Model struct {
+	ctx        context.Context
+	service    *MetricsService
+	interval   time.Duration
+	onMetrics  func(*SystemMetrics)
+	receiver   *AlertReceiver
+	thresholds ColorThresholds
+
+	metrics *SystemMetrics
+	err     error
+	width   int
+	height  int
+}
+
+// NewModel creates a monitor model that samples every interval. onMetrics, if set,
+// is called with each sample, e.g. to evaluate alerts.
+func NewModel(ctx context.Context, service *MetricsService, interval time.Duration, onMetrics func(*SystemMetrics)) Model {
+	return Model{
+		ctx:        ctx,
+		service:    service,
+		interval:   interval,
+		onMetrics:  onMetrics,
+		thresholds: DefaultColorThresholds,
+	}
+}
+
+// WithReceiver shows the alerts accepted by receiver below the metrics
+func (m Model) WithReceiver(receiver *AlertReceiver) Model {
+	m.receiver = receiver
+	return m
+}
+
+// WithColorThresholds changes the usage percentages at which colors change
+func (m Model) WithColorThresholds(thresholds ColorThresholds) Model {
+	m.thresholds = thresholds
+	return m
+}
+
+// Run starts the monitor TUI and blocks until the user quits
+func Run(ctx context.Context, model Model) error {
+	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithContext(ctx))
+	if _, err := p.Run(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to run monitor: %w", err)
+	}
+	return nil
+}
+
+// Init collects the first sample right away
+func (m Model) Init() tea.Cmd {
+	return m.tick(0)
+}
+
+// tick collects a sample after delay. Collection happens inside the tick so the UI stays responsive.
+func (m Model) tick(delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		metrics, err := m.service.GetMetrics(m.ctx)
+		return metricsMsg{metrics: metrics, err: err}
+	})
+}
+
+// Update handles key presses, resizes and new samples
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case metricsMsg:
+		m.err = msg.err
+		if msg.err == nil {
+			m.metrics = msg.metrics
+			if m.onMetrics != nil {
+				m.onMetrics(msg.metrics)
+			}
+		}
+		return m, m.tick(m.interval)
+	}
+
+	return m, nil
+}
+
+// View renders the latest sample sized to the terminal
+func (m Model) View() string {
+	if m.metrics == nil {
+		if m.err != nil {
+			return errorStyle.Render(fmt.Sprintf("Failed to collect metrics: %v", m.err)) + "\n"
+		}
+		return dimStyle.Render("Collecting metrics...") + "\n"
+	}
+
+	var received []Alert
+	if m.receiver != nil {
+		received = m.receiver.Recent()
+	}
+
+	view := renderMetrics(m.metrics, received, m.width, m.thresholds)
+	if m.err != nil {
+		view += "\n" + errorStyle.Render(fmt.Sprintf("Failed to collect metrics: %v", m.err))
+	}
+
+	// Drop lines that do not fit rather than letting the alt screen scroll
+	if lines := strings.Split(view, "\n"); m.height > 0 && len(lines) > m.height {
+		view = strings.Join(lines[:m.height], "\n")
+	}
+	return view
+}
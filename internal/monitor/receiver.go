@@ -0,0 +1,113 @@
+package monitor
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// ReceivePath is where AlertReceiver accepts alerts
+	ReceivePath = "/alert"
+
+	// receivedAlertsKept is how many received alerts the monitor shows
+	receivedAlertsKept = 10
+
+	maxAlertBodySize = 64 << 10
+)
+
+// AlertReceiver accepts alerts posted by the webhook alerter of other finks
+// instances, so one monitor can show the alerts of many servers
+type AlertReceiver struct {
+	// Secret must have signed each request body (X-Finks-Signature). Without it,
+	// every request is rejected.
+	Secret string
+
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func NewAlertReceiver(secret string) *AlertReceiver {
+	return &AlertReceiver{Secret: secret}
+}
+
+// Start listens on port and serves POST /alert in the background until ctx is done
+func (r *AlertReceiver) Start(ctx context.Context, port int) error {
+	listener, err := net.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", port, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("POST "+ReceivePath, r)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("alert receiver stopped", "port", port, "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	return nil
+}
+
+// ServeHTTP verifies and stores one alert
+func (r *AlertReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxAlertBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	signature := req.Header.Get(SignatureHeader)
+	if r.Secret == "" || !hmac.Equal([]byte(signature), []byte(Sign(body, r.Secret))) {
+		slog.Warn("rejected alert with invalid signature", "remote", req.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var alert Alert
+	if err := json.Unmarshal(body, &alert); err != nil || alert.Resource == "" {
+		http.Error(w, "invalid alert", http.StatusBadRequest)
+		return
+	}
+	if alert.Timestamp.IsZero() {
+		alert.Timestamp = time.Now()
+	}
+
+	slog.Warn("alert received", "hostname", alert.Hostname, "resource", alert.Subject(), "level", alert.Level, "value", alert.Value)
+	r.mu.Lock()
+	r.alerts = append(r.alerts, alert)
+	if len(r.alerts) > receivedAlertsKept {
+		r.alerts = r.alerts[len(r.alerts)-receivedAlertsKept:]
+	}
+	r.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Recent returns the latest received alerts, newest first
+func (r *AlertReceiver) Recent() []Alert {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	alerts := make([]Alert, len(r.alerts))
+	for i, alert := range r.alerts {
+		alerts[len(r.alerts)-1-i] = alert
+	}
+	return alerts
+}
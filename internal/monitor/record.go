@@ -0,0 +1,164 @@
+package monitor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// MetricsSample is the part of a metrics sample that is recorded for charts
+type MetricsSample struct {
+	Timestamp     time.Time `json:"timestamp"`
+	CPUPercent    float64   `json:"cpu_percent"`
+	MemoryPercent float64   `json:"memory_percent"`
+	// DiskPercent is the usage of the root partition
+	DiskPercent float64 `json:"disk_percent"`
+	RecvPerSec  float64 `json:"recv_per_sec"`
+	SentPerSec  float64 `json:"sent_per_sec"`
+}
+
+func metricsHistoryPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".finks", "metrics.jsonl"), nil
+}
+
+// metricsPruneInterval is how often the recorder drops samples older than its retention
+const metricsPruneInterval = time.Hour
+
+// MetricsRecorder appends metrics samples to ~/.finks/metrics.jsonl
+type MetricsRecorder struct {
+	mu        sync.Mutex
+	retention time.Duration
+	lastPrune time.Time
+}
+
+// NewMetricsRecorder returns a recorder that keeps samples for retention (0 keeps them all)
+func NewMetricsRecorder(retention time.Duration) *MetricsRecorder {
+	return &MetricsRecorder{retention: retention}
+}
+
+// Record appends the chart values of a metrics sample as one JSON line. Samples older
+// than the retention are dropped on the first call and then once an hour.
+func (r *MetricsRecorder) Record(m *SystemMetrics) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sample := MetricsSample{
+		Timestamp:     m.Timestamp,
+		CPUPercent:    m.CPU.UsagePercent,
+		MemoryPercent: m.Memory.UsedPercent,
+		RecvPerSec:    m.Network.RecvPerSec,
+		SentPerSec:    m.Network.SentPerSec,
+	}
+	for _, p := range m.Disk.Partitions {
+		if p.Mountpoint == "/" {
+			sample.DiskPercent = p.UsedPercent
+		}
+	}
+
+	path, err := metricsHistoryPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open metrics history: %w", err)
+	}
+	defer f.Close()
+
+	if err := json.NewEncoder(f).Encode(sample); err != nil {
+		return fmt.Errorf("failed to write metrics history: %w", err)
+	}
+
+	if r.retention > 0 && time.Since(r.lastPrune) >= metricsPruneInterval {
+		r.lastPrune = time.Now()
+		return pruneMetricsHistory(path, time.Now().Add(-r.retention))
+	}
+	return nil
+}
+
+// pruneMetricsHistory rewrites the history at path without the samples taken before cutoff
+func pruneMetricsHistory(path string, cutoff time.Time) error {
+	samples, err := readMetricsHistory(path, cutoff)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "metrics-*.jsonl")
+	if err != nil {
+		return fmt.Errorf("failed to prune metrics history: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, sample := range samples {
+		if err := enc.Encode(sample); err != nil {
+			tmp.Close()
+			return fmt.Errorf("failed to prune metrics history: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to prune metrics history: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to prune metrics history: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to prune metrics history: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to prune metrics history: %w", err)
+	}
+	return nil
+}
+
+// LoadMetricsHistory returns the recorded samples taken at or after since, oldest first
+func LoadMetricsHistory(since time.Time) ([]MetricsSample, error) {
+	path, err := metricsHistoryPath()
+	if err != nil {
+		return nil, err
+	}
+	return readMetricsHistory(path, since)
+}
+
+// readMetricsHistory reads the samples at path taken at or after since
+func readMetricsHistory(path string, since time.Time) ([]MetricsSample, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open metrics history: %w", err)
+	}
+	defer f.Close()
+
+	var samples []MetricsSample
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var sample MetricsSample
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			// Skip lines that were cut short rather than failing the whole history
+			continue
+		}
+		if !sample.Timestamp.Before(since) {
+			samples = append(samples, sample)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read metrics history: %w", err)
+	}
+	return samples, nil
+}
@@ -0,0 +1,54 @@
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsRecorderPrunesOldSamples(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	now := time.Now()
+	recorder := NewMetricsRecorder(24 * time.Hour)
+	for _, age := range []time.Duration{72 * time.Hour, 25 * time.Hour, time.Hour} {
+		recorder.lastPrune = time.Now()
+		require.NoError(t, recorder.Record(&SystemMetrics{Timestamp: now.Add(-age)}))
+	}
+
+	// Samples are only dropped once the prune interval has passed
+	samples, err := LoadMetricsHistory(time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, samples, 3)
+
+	recorder.lastPrune = time.Time{}
+	require.NoError(t, recorder.Record(&SystemMetrics{Timestamp: now}))
+
+	samples, err = LoadMetricsHistory(time.Time{})
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.True(t, samples[0].Timestamp.Equal(now.Add(-time.Hour)))
+	assert.True(t, samples[1].Timestamp.Equal(now))
+
+	path, err := metricsHistoryPath()
+	require.NoError(t, err)
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "the temporary file is renamed over the history")
+}
+
+func TestMetricsRecorderWithoutRetention(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	recorder := NewMetricsRecorder(0)
+	require.NoError(t, recorder.Record(&SystemMetrics{Timestamp: time.Now().Add(-365 * 24 * time.Hour)}))
+	require.NoError(t, recorder.Record(&SystemMetrics{Timestamp: time.Now()}))
+
+	samples, err := LoadMetricsHistory(time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, samples, 2)
+}
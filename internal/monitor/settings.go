@@ -0,0 +1,66 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AlertSettings is the persisted alert channel configuration
+type AlertSettings struct {
+	WebhookURL    string `json:"webhook_url,omitempty"`
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+}
+
+func alertSettingsPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".finks", "alerts.json"), nil
+}
+
+// LoadAlertSettings reads the alert settings, returning empty settings if none are saved
+func LoadAlertSettings() (*AlertSettings, error) {
+	path, err := alertSettingsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &AlertSettings{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return settings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read alert settings: %w", err)
+	}
+
+	if err := json.Unmarshal(data, settings); err != nil {
+		return nil, fmt.Errorf("failed to parse alert settings: %w", err)
+	}
+	return settings, nil
+}
+
+// SaveAlertSettings persists the alert settings. The file may contain a secret, so it is private.
+func SaveAlertSettings(settings *AlertSettings) error {
+	path, err := alertSettingsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert settings: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write alert settings: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package monitor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// snapshotWidth is the terminal width snapshots are rendered for
+const snapshotWidth = 120
+
+// ansiPattern matches the terminal escape sequences used for colors and styles
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// SaveSnapshot writes the rendered metrics as plain text to metrics_<timestamp>.txt
+// in dir, then deletes snapshots in dir older than retention (0 keeps them all)
+func SaveSnapshot(dir string, metrics *SystemMetrics, retention time.Duration) error {
+	text := ansiPattern.ReplaceAllString(renderMetrics(metrics, nil, snapshotWidth, DefaultColorThresholds), "")
+
+	name := fmt.Sprintf("metrics_%s.txt", metrics.Timestamp.Format("20060102-150405"))
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(text+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if retention > 0 {
+		return pruneSnapshots(dir, time.Now().Add(-retention))
+	}
+	return nil
+}
+
+// pruneSnapshots removes snapshot files in dir last modified before cutoff
+func pruneSnapshots(dir string, cutoff time.Time) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "metrics_") || !strings.HasSuffix(name, ".txt") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.ModTime().Before(cutoff) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove old snapshot %s: %w", name, err)
+		}
+	}
+	return nil
+}
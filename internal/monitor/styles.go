@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	// sectionStyle is the border style for each metric panel
+	sectionStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("62")).
+			Padding(0, 1)
+
+	// titleStyle is used for panel titles
+	titleStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("62"))
+
+	// dimStyle is used for secondary text
+	dimStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241"))
+
+	// errorStyle is used when metrics cannot be collected
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("203"))
+
+	// watchedStyle highlights processes matching a watch pattern
+	watchedStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("11"))
+)
+
+// Usage colors, from idle to saturated
+var (
+	colorLow      = lipgloss.Color("78")
+	colorMedium   = lipgloss.Color("11")
+	colorHigh     = lipgloss.Color("209")
+	colorCritical = lipgloss.Color("203")
+)
+
+// ColorThresholds are the usage percentages from which the medium, high and
+// critical colors are used
+type ColorThresholds struct {
+	Low    float64
+	Medium float64
+	High   float64
+}
+
+// DefaultColorThresholds turn usage yellow at 50%, orange at 70% and red at 90%
+var DefaultColorThresholds = ColorThresholds{Low: 50, Medium: 70, High: 90}
+
+// Validate checks that the thresholds are percentages in ascending order
+func (t ColorThresholds) Validate() error {
+	for _, v := range []float64{t.Low, t.Medium, t.High} {
+		if v < 0 || v > 100 {
+			return fmt.Errorf("color thresholds must be between 0 and 100, got %g", v)
+		}
+	}
+	if t.Low >= t.Medium || t.Medium >= t.High {
+		return fmt.Errorf("color thresholds must be in ascending order, got low %g, medium %g, high %g", t.Low, t.Medium, t.High)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package monitor
+
+import "time"
+
+type SystemMetrics struct {
+	Timestamp time.Time
+	Hostname  string
+	Uptime    time.Duration
+	CPU       CPUMetrics
+	Memory    MemoryMetrics
+	Disk      DiskMetrics
+	Network   NetworkMetrics
+	Load      LoadMetrics
+	Processes ProcessMetrics
+}
+
+type CPUMetrics struct {
+	UsagePercent float64
+	PerCore      []float64
+	Cores        int
+	ModelName    string
+	// History holds the recent usage of each core, oldest first, when per-CPU history is kept
+	History [][]float64
+	// Governor and FrequencyMHz describe the frequency scaling of the first core on
+	// Linux; they are empty when cpufreq is unavailable
+	Governor     string
+	FrequencyMHz float64
+}
+
+type MemoryMetrics struct {
+	Total       uint64
+	Used        uint64
+	Available   uint64
+	UsedPercent float64
+	SwapTotal   uint64
+	SwapUsed    uint64
+	SwapPercent float64
+}
+
+type DiskMetrics struct {
+	Partitions       []PartitionMetrics
+	ReadBytesPerSec  float64
+	WriteBytesPerSec float64
+	IODetail         []DiskIOMetrics
+}
+
+// DiskIOMetrics holds I/O rates for the device backing a selected mountpoint
+type DiskIOMetrics struct {
+	Mountpoint       string
+	Device           string
+	ReadBytesPerSec  float64
+	WriteBytesPerSec float64
+}
+
+type PartitionMetrics struct {
+	Mountpoint  string
+	Device      string
+	Fstype      string
+	Total       uint64
+	Used        uint64
+	UsedPercent float64
+}
+
+type NetworkMetrics struct {
+	BytesRecv  uint64
+	BytesSent  uint64
+	RecvPerSec float64
+	SentPerSec float64
+}
+
+type LoadMetrics struct {
+	Load1  float64
+	Load5  float64
+	Load15 float64
+}
+
+type ProcessMetrics struct {
+	Total     int
+	TopCPU    []ProcessInfo
+	TopMemory []ProcessInfo
+	Watched   []ProcessInfo
+}
+
+type ProcessInfo struct {
+	PID  int32
+	Name string
+	// Username is the process owner, or its UID when the name cannot be resolved.
+	// It is only collected when MetricsOptions.Users is set.
+	Username      string
+	CPUPercent    float64
+	MemoryPercent float32
+	MemoryRSS     uint64
+	Watched       bool
+}
+
+// MetricsOptions configures what the MetricsService collects
+type MetricsOptions struct {
+	// WatchPatterns are glob patterns (e.g. nginx*) for process names to highlight
+	WatchPatterns []string
+	// DiskPaths are mountpoints to report I/O for; when empty, I/O is aggregated across all devices
+	DiskPaths []string
+	// Users collects the owner of each process
+	Users bool
+	// FilterUser limits the process lists to processes owned by this user name or UID
+	FilterUser string
+	// TopCount is the length of the top CPU and memory lists; 0 uses the default of 5
+	TopCount int
+	// PerCPU keeps recent per-core usage so it can be drawn as sparklines
+	PerCPU bool
+	// ProcessFilter limits the top CPU and memory lists to processes with matching names
+	ProcessFilter ProcessFilter
+}
+
+// ProcessFilter selects processes by name with glob patterns (e.g. nginx*), as
+// matched by filepath.Match
+type ProcessFilter struct {
+	// IncludePatterns, when set, keeps only processes matching one of them
+	IncludePatterns []string
+	// ExcludePatterns drops processes matching one of them, even if they are included
+	ExcludePatterns []string
+}
+
+// Matches reports whether a process with this name passes the filter
+func (f ProcessFilter) Matches(name string) bool {
+	if len(f.IncludePatterns) > 0 && !matchesAny(name, f.IncludePatterns) {
+		return false
+	}
+	return !matchesAny(name, f.ExcludePatterns)
+}
@@ -0,0 +1,87 @@
+package monitor
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the hex-encoded HMAC-SHA256 of the request body
+	SignatureHeader = "X-Finks-Signature"
+
+	webhookAttempts       = 3
+	webhookInitialBackoff = 1 * time.Second
+	webhookTimeout        = 10 * time.Second
+)
+
+// WebhookAlerter posts alerts as JSON to an HTTP endpoint
+type WebhookAlerter struct {
+	URL    string
+	Secret string
+	client *http.Client
+}
+
+func NewWebhookAlerter(url, secret string) *WebhookAlerter {
+	return &WebhookAlerter{
+		URL:    url,
+		Secret: secret,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Send posts the alert, retrying with exponential backoff on failure
+func (w *WebhookAlerter) Send(alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	backoff := webhookInitialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= webhookAttempts; attempt++ {
+		if lastErr = w.post(body); lastErr == nil {
+			return nil
+		}
+		if attempt < webhookAttempts {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+
+	return fmt.Errorf("failed to send alert to %s after %d attempts: %w", w.URL, webhookAttempts, lastErr)
+}
+
+func (w *WebhookAlerter) post(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(body, w.Secret))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of body using secret
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,105 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// Access log formats supported by Traefik
+const (
+	AccessLogFormatCommon = "common"
+	AccessLogFormatJSON   = "json"
+)
+
+// accessLogPath is where Traefik writes the access log inside the container
+const accessLogPath = "/logs/access.log"
+
+// EnableAccessLog turns on Traefik access logging in the given format. Traefik only
+// reads its static configuration at startup, so the container is recreated.
+func EnableAccessLog(ctx context.Context, dockerClient *docker.Client, format string) error {
+	if format != AccessLogFormatCommon && format != AccessLogFormatJSON {
+		return fmt.Errorf("invalid access log format %q: must be %s or %s", format, AccessLogFormatCommon, AccessLogFormatJSON)
+	}
+
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	logDir := filepath.Join(homeDir, ".finks", "traefik-logs")
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		return fmt.Errorf("failed to create access log directory: %w", err)
+	}
+
+	settings.AccessLogFormat = format
+	settings.AccessLogDir = logDir
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
+
+// DisableAccessLog turns off Traefik access logging, recreating the container
+func DisableAccessLog(ctx context.Context, dockerClient *docker.Client) error {
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	settings.AccessLogFormat = ""
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
+
+// TailAccessLog streams the access log from the Traefik container to w, starting
+// with the last lines entries. It returns when ctx is cancelled or the stream ends.
+func TailAccessLog(ctx context.Context, dockerClient *docker.Client, lines int, w io.Writer) error {
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+	if settings.AccessLogFormat == "" {
+		return fmt.Errorf("access log is not enabled; run 'finks proxy access-log enable' first")
+	}
+
+	cmd := []string{"tail", "-n", strconv.Itoa(lines), "-F", accessLogPath}
+	exitCode, err := dockerClient.ExecInContainer(ctx, traefikContainerName, cmd, w, w)
+	if err != nil {
+		return fmt.Errorf("failed to read access log: %w", err)
+	}
+	if exitCode != 0 && ctx.Err() == nil {
+		return fmt.Errorf("tail exited with code %d", exitCode)
+	}
+	return nil
+}
+
+// loadBuiltinSettings loads the proxy settings after checking that Traefik is
+// installed and configured by finks rather than by a static config file
+func loadBuiltinSettings(ctx context.Context, dockerClient *docker.Client) (*Settings, error) {
+	if err := requireTraefikContainer(ctx, dockerClient); err != nil {
+		return nil, err
+	}
+
+	settings, err := LoadSettings()
+	if err != nil {
+		return nil, err
+	}
+	if settings.StaticConfigFile != "" {
+		return nil, fmt.Errorf("traefik uses the static config file %s; change the setting there instead", settings.StaticConfigFile)
+	}
+	return settings, nil
+}
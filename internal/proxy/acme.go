@@ -0,0 +1,148 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// acmeStoragePath is where Traefik keeps the Let's Encrypt account and certificates
+const acmeStoragePath = "/letsencrypt/acme.json"
+
+// BackupACME reads acme.json out of the Traefik container
+func BackupACME(ctx context.Context, dockerClient *docker.Client) ([]byte, error) {
+	if err := requireTraefikContainer(ctx, dockerClient); err != nil {
+		return nil, err
+	}
+
+	data, err := dockerClient.CopyFromContainer(ctx, traefikContainerName, acmeStoragePath)
+	if errors.Is(err, docker.ErrPathNotFound) {
+		return nil, fmt.Errorf("no ACME certificates found at %s", acmeStoragePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ACME storage: %w", err)
+	}
+	return data, nil
+}
+
+// RestoreACME writes acme.json into the Traefik container and restarts it so the
+// certificates are loaded
+func RestoreACME(ctx context.Context, dockerClient *docker.Client, data []byte) error {
+	if !json.Valid(data) {
+		return fmt.Errorf("ACME backup is not valid JSON")
+	}
+	if err := requireTraefikContainer(ctx, dockerClient); err != nil {
+		return err
+	}
+
+	// Traefik refuses to use acme.json unless only the owner can read it
+	if err := dockerClient.CopyToContainer(ctx, traefikContainerName, acmeStoragePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write ACME storage: %w", err)
+	}
+
+	status, err := dockerClient.GetContainerStatus(ctx, traefikContainerName)
+	if err != nil {
+		return fmt.Errorf("failed to get Traefik container status: %w", err)
+	}
+	if strings.Contains(strings.ToLower(status), "running") {
+		if err := dockerClient.StopContainer(ctx, traefikContainerName); err != nil {
+			return fmt.Errorf("failed to stop Traefik container: %w", err)
+		}
+	}
+	if err := dockerClient.StartContainer(ctx, traefikContainerName); err != nil {
+		return fmt.Errorf("failed to start Traefik container: %w", err)
+	}
+	return nil
+}
+
+// HasACMECertificates reports whether the Traefik container holds a non-empty acme.json
+func HasACMECertificates(ctx context.Context, dockerClient *docker.Client) (bool, error) {
+	data, err := dockerClient.CopyFromContainer(ctx, traefikContainerName, acmeStoragePath)
+	if errors.Is(err, docker.ErrPathNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read ACME storage: %w", err)
+	}
+	return len(strings.TrimSpace(string(data))) > 0, nil
+}
+
+// ACMEStorageMount returns the host path or volume name that acme.json is stored
+// on, or "" when it is only kept in the Traefik container's own filesystem. Storage
+// on a mount survives the removal of the container.
+func ACMEStorageMount(ctx context.Context, dockerClient *docker.Client) (string, error) {
+	detail, err := dockerClient.ContainerInspect(ctx, traefikContainerName)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect Traefik container: %w", err)
+	}
+
+	for dir := path.Dir(acmeStoragePath); ; dir = path.Dir(dir) {
+		for _, mount := range detail.Mounts {
+			if path.Clean(mount.Destination) != dir {
+				continue
+			}
+			if mount.Name != "" {
+				return mount.Name, nil
+			}
+			return mount.Source, nil
+		}
+		if dir == "/" {
+			return "", nil
+		}
+	}
+}
+
+// countACMECertificates returns the number of certificates across all resolvers in acme.json
+func countACMECertificates(ctx context.Context, dockerClient *docker.Client) (int, error) {
+	data, err := dockerClient.CopyFromContainer(ctx, traefikContainerName, acmeStoragePath)
+	if errors.Is(err, docker.ErrPathNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read ACME storage: %w", err)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return 0, nil
+	}
+
+	var resolvers map[string]struct {
+		Certificates []json.RawMessage `json:"Certificates"`
+	}
+	if err := json.Unmarshal(data, &resolvers); err != nil {
+		return 0, fmt.Errorf("failed to parse ACME storage: %w", err)
+	}
+
+	count := 0
+	for _, resolver := range resolvers {
+		count += len(resolver.Certificates)
+	}
+	return count, nil
+}
+
+// RemoveTraefik removes the Traefik container. The Traefik network is kept, since
+// app containers may still be attached to it.
+func RemoveTraefik(ctx context.Context, dockerClient *docker.Client) error {
+	if err := requireTraefikContainer(ctx, dockerClient); err != nil {
+		return err
+	}
+	if err := dockerClient.RemoveContainer(ctx, traefikContainerName, true); err != nil {
+		return fmt.Errorf("failed to remove Traefik container: %w", err)
+	}
+	return nil
+}
+
+func requireTraefikContainer(ctx context.Context, dockerClient *docker.Client) error {
+	exists, err := dockerClient.ContainerExists(ctx, traefikContainerName)
+	if err != nil {
+		return fmt.Errorf("failed to check if Traefik container exists: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("traefik is not installed; run 'finks proxy install' first")
+	}
+	return nil
+}
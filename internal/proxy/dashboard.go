@@ -0,0 +1,67 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// AllowDashboardIPs adds CIDRs to the dashboard allowlist and recreates the Traefik
+// container with it. A bare IP address is treated as a single-host CIDR. It returns
+// the resulting allowlist.
+func AllowDashboardIPs(ctx context.Context, dockerClient *docker.Client, cidrs []string) ([]string, error) {
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cidr := range cidrs {
+		normalized, err := normalizeCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(settings.DashboardAllowedIPs, normalized) {
+			settings.DashboardAllowedIPs = append(settings.DashboardAllowedIPs, normalized)
+		}
+	}
+
+	if err := SaveSettings(settings); err != nil {
+		return nil, err
+	}
+	if err := InstallTraefik(ctx, dockerClient); err != nil {
+		return nil, err
+	}
+	return settings.DashboardAllowedIPs, nil
+}
+
+// ClearDashboardAllowlist removes the dashboard allowlist, leaving the dashboard open again
+func ClearDashboardAllowlist(ctx context.Context, dockerClient *docker.Client) error {
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	settings.DashboardAllowedIPs = nil
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+	return InstallTraefik(ctx, dockerClient)
+}
+
+func normalizeCIDR(value string) (string, error) {
+	if ip := net.ParseIP(value); ip != nil {
+		if ip.To4() != nil {
+			return value + "/32", nil
+		}
+		return value + "/128", nil
+	}
+
+	_, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid CIDR %q", value)
+	}
+	return network.String(), nil
+}
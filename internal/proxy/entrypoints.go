@@ -0,0 +1,118 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// entrypointNamePattern keeps entrypoint names usable in Traefik environment variable names
+var entrypointNamePattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+// builtinEntrypoints are defined by finks itself and cannot be replaced
+var builtinEntrypoints = []string{"web", "websecure", "traefik", metricsEntrypoint}
+
+// AddEntrypoint adds or updates a custom entrypoint and recreates the container so
+// Traefik listens on it. The entrypoint's port is published on the host.
+func AddEntrypoint(ctx context.Context, dockerClient *docker.Client, name, address string) error {
+	if !entrypointNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid entrypoint name %q: use letters and digits only", name)
+	}
+	if slices.Contains(builtinEntrypoints, strings.ToLower(name)) {
+		return fmt.Errorf("entrypoint %s is managed by finks", name)
+	}
+	if _, err := entrypointPortSpec(address); err != nil {
+		return err
+	}
+
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	if settings.Entrypoints == nil {
+		settings.Entrypoints = make(map[string]string)
+	}
+	settings.Entrypoints[name] = address
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
+
+// RemoveEntrypoint removes a custom entrypoint and recreates the container without it.
+// Unless force is set, it refuses while routers known to the Traefik API at apiURL use it.
+func RemoveEntrypoint(ctx context.Context, dockerClient *docker.Client, name, apiURL string, force bool) error {
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+	if _, ok := settings.Entrypoints[name]; !ok {
+		return fmt.Errorf("entrypoint %s is not configured", name)
+	}
+
+	if !force {
+		routers, err := RoutersUsingEntrypoint(ctx, apiURL, name)
+		if err != nil {
+			return fmt.Errorf("failed to check routers using entrypoint %s: %w", name, err)
+		}
+		if len(routers) > 0 {
+			return fmt.Errorf("entrypoint %s is used by routers %s", name, strings.Join(routers, ", "))
+		}
+	}
+
+	delete(settings.Entrypoints, name)
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
+
+// entrypointPortSpec turns a Traefik entrypoint address ([host]:port[/tcp|/udp])
+// into the Docker port mapping that publishes it
+func entrypointPortSpec(address string) (string, error) {
+	hostPort, protocol, hasProtocol := strings.Cut(address, "/")
+	if hasProtocol && protocol != "tcp" && protocol != "udp" {
+		return "", fmt.Errorf("invalid entrypoint address %q: protocol must be tcp or udp", address)
+	}
+
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return "", fmt.Errorf("invalid entrypoint address %q: expected [host]:port, e.g. :50051", address)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return "", fmt.Errorf("invalid entrypoint address %q: port must be between 1 and 65535", address)
+	}
+
+	spec := port + ":" + port
+	if host != "" {
+		spec = host + ":" + spec
+	}
+	if hasProtocol {
+		spec += "/" + protocol
+	}
+	return spec, nil
+}
+
+// addEntrypoints configures the custom entrypoints on the Traefik container options
+func addEntrypoints(opts *docker.RunOptions, entrypoints map[string]string) {
+	var recorded []string
+	for _, name := range slices.Sorted(maps.Keys(entrypoints)) {
+		address := entrypoints[name]
+		opts.EnvVars[fmt.Sprintf("TRAEFIK_ENTRYPOINTS_%s_ADDRESS", strings.ToUpper(name))] = address
+		if spec, err := entrypointPortSpec(address); err == nil {
+			opts.Ports = append(opts.Ports, spec)
+		}
+		recorded = append(recorded, name+"="+address)
+	}
+	opts.Labels[entrypointsLabel] = strings.Join(recorded, ",")
+}
@@ -0,0 +1,80 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL is the address of the Traefik API exposed by InstallTraefik
+const DefaultAPIURL = "http://localhost:8080"
+
+// DashboardURL is where the Traefik dashboard is served on the host
+const DashboardURL = DefaultAPIURL + "/dashboard/"
+
+const healthCheckTimeout = 5 * time.Second
+
+// ErrAPINotEnabled is returned when Traefik responds but its API is not enabled
+var ErrAPINotEnabled = errors.New("traefik API not enabled")
+
+type overviewSection struct {
+	Total    int `json:"total"`
+	Warnings int `json:"warnings"`
+	Errors   int `json:"errors"`
+}
+
+type overviewResponse struct {
+	HTTP struct {
+		Routers     overviewSection `json:"routers"`
+		Services    overviewSection `json:"services"`
+		Middlewares overviewSection `json:"middlewares"`
+	} `json:"http"`
+	Providers []string `json:"providers"`
+}
+
+// CheckTraefikHealth queries the Traefik API overview endpoint at apiURL
+func CheckTraefikHealth(ctx context.Context, apiURL string) (*TraefikHealth, error) {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	url := strings.TrimRight(apiURL, "/") + "/api/overview"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach Traefik API at %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrAPINotEnabled
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("traefik API returned %s", resp.Status)
+	}
+
+	var overview overviewResponse
+	if err := json.NewDecoder(resp.Body).Decode(&overview); err != nil {
+		return nil, fmt.Errorf("failed to decode Traefik API response: %w", err)
+	}
+
+	return &TraefikHealth{
+		Providers:   overview.Providers,
+		Routers:     overview.HTTP.Routers.Total,
+		Services:    overview.HTTP.Services.Total,
+		Middlewares: overview.HTTP.Middlewares.Total,
+		Warnings:    overview.HTTP.Routers.Warnings + overview.HTTP.Services.Warnings + overview.HTTP.Middlewares.Warnings,
+		Errors:      overview.HTTP.Routers.Errors + overview.HTTP.Services.Errors + overview.HTTP.Middlewares.Errors,
+	}, nil
+}
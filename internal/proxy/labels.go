@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultHSTSMaxAge is one year, the minimum accepted by browser HSTS preload lists
+const DefaultHSTSMaxAge = 31536000
+
+// AddHSTSLabels configures a headers middleware that sends Strict-Transport-Security
+func AddHSTSLabels(labels map[string]string, middlewareName string, maxAge int, includeSubdomains, preload bool) {
+	prefix := fmt.Sprintf("traefik.http.middlewares.%s.headers.", middlewareName)
+	labels[prefix+"stsSeconds"] = strconv.Itoa(maxAge)
+	labels[prefix+"stsIncludeSubdomains"] = strconv.FormatBool(includeSubdomains)
+	labels[prefix+"stsPreload"] = strconv.FormatBool(preload)
+}
+
+// AddSecurityHeadersLabels configures a headers middleware with a standard set of
+// security headers, including HSTS with the given max age
+func AddSecurityHeadersLabels(labels map[string]string, middlewareName string, maxAge int) {
+	prefix := fmt.Sprintf("traefik.http.middlewares.%s.headers.", middlewareName)
+	labels[prefix+"contentTypeNosniff"] = "true"
+	labels[prefix+"browserXssFilter"] = "true"
+	labels[prefix+"frameDeny"] = "true"
+	labels[prefix+"forceSTSHeader"] = "true"
+	AddHSTSLabels(labels, middlewareName, maxAge, true, false)
+}
+
+// AddRouterMiddleware appends a middleware to the router of an app
+func AddRouterMiddleware(labels map[string]string, appName, middlewareName string) {
+	key := fmt.Sprintf("traefik.http.routers.%s.middlewares", sanitizeName(appName))
+	if existing := labels[key]; existing != "" {
+		for _, name := range strings.Split(existing, ",") {
+			if name == middlewareName {
+				return
+			}
+		}
+		labels[key] = existing + "," + middlewareName
+		return
+	}
+	labels[key] = middlewareName
+}
+
+// SecurityHeadersMiddleware returns the name of the security headers middleware of an app
+func SecurityHeadersMiddleware(appName string) string {
+	return sanitizeName(appName) + "-security-headers"
+}
+
+// dashboardMiddleware is the IP allowlist middleware guarding the Traefik dashboard
+const dashboardMiddleware = "dashboard-auth"
+
+// addDashboardAllowlistLabels routes the dashboard and API through a router that only
+// accepts requests from the given CIDRs. The labels go on the Traefik container itself.
+func addDashboardAllowlistLabels(labels map[string]string, cidrs []string) {
+	sourceRange := strings.Join(cidrs, ",")
+
+	labels["traefik.enable"] = "true"
+	labels["traefik.http.routers.api.rule"] = "PathPrefix(`/api`) || PathPrefix(`/dashboard`)"
+	labels["traefik.http.routers.api.entrypoints"] = "traefik"
+	labels["traefik.http.routers.api.service"] = "api@internal"
+	labels["traefik.http.routers.api.middlewares"] = dashboardMiddleware
+	labels[fmt.Sprintf("traefik.http.middlewares.%s.ipallowlist.sourcerange", dashboardMiddleware)] = sourceRange
+	labels[dashboardAllowlistLabel] = sourceRange
+}
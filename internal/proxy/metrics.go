@@ -0,0 +1,154 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// DefaultMetricsAddr is where the Prometheus metrics entrypoint listens unless another address is given
+const DefaultMetricsAddr = ":8082"
+
+// metricsEntrypoint is the entrypoint that serves /metrics
+const metricsEntrypoint = "metrics"
+
+// EnableMetrics serves Traefik's Prometheus metrics on a dedicated entrypoint at addr,
+// recreating the container
+func EnableMetrics(ctx context.Context, dockerClient *docker.Client, addr string) error {
+	if _, err := entrypointPortSpec(addr); err != nil {
+		return err
+	}
+	if strings.HasSuffix(addr, "/udp") {
+		return fmt.Errorf("invalid metrics address %q: metrics are served over tcp", addr)
+	}
+
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	settings.MetricsAddr = addr
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
+
+// DisableMetrics turns off the Prometheus metrics entrypoint, recreating the container
+func DisableMetrics(ctx context.Context, dockerClient *docker.Client) error {
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	settings.MetricsAddr = ""
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
+
+// MetricsURL returns the URL the metrics entrypoint at addr is reachable on from this host
+func MetricsURL(addr string) string {
+	host, port, err := net.SplitHostPort(strings.TrimSuffix(addr, "/tcp"))
+	if err != nil {
+		return ""
+	}
+	if host == "" || host == "0.0.0.0" {
+		host = "localhost"
+	}
+	return "http://" + net.JoinHostPort(host, port) + "/metrics"
+}
+
+// ScrapeMetrics fetches url and checks that it serves the Prometheus text format.
+// It returns the names of the metric families found, sorted.
+func ScrapeMetrics(ctx context.Context, url string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach metrics endpoint at %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metrics endpoint returned %s", resp.Status)
+	}
+
+	families := make(map[string]bool)
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			// "# TYPE <name> <type>" names a family even before it has samples
+			if fields := strings.Fields(line); len(fields) >= 3 && fields[1] == "TYPE" {
+				families[fields[2]] = true
+			}
+			continue
+		}
+
+		name, ok := sampleName(line)
+		if !ok {
+			return nil, fmt.Errorf("line %d is not in Prometheus text format: %q", lineNo, line)
+		}
+		families[name] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read metrics: %w", err)
+	}
+	if len(families) == 0 {
+		return nil, fmt.Errorf("metrics endpoint at %s returned no metrics", url)
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// sampleName returns the metric name of a sample line such as `name{label="v"} 1`
+func sampleName(line string) (string, bool) {
+	end := strings.IndexAny(line, "{ ")
+	if end <= 0 {
+		return "", false
+	}
+	name := line[:end]
+	for i, r := range name {
+		valid := r == '_' || r == ':' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9')
+		if !valid {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+// addMetrics configures the Prometheus metrics entrypoint on the Traefik container options
+func addMetrics(opts *docker.RunOptions, addr string) {
+	opts.EnvVars["TRAEFIK_METRICS_PROMETHEUS"] = "true"
+	opts.EnvVars["TRAEFIK_METRICS_PROMETHEUS_ENTRYPOINT"] = metricsEntrypoint
+	opts.EnvVars["TRAEFIK_ENTRYPOINTS_METRICS_ADDRESS"] = addr
+	if spec, err := entrypointPortSpec(addr); err == nil {
+		opts.Ports = append(opts.Ports, spec)
+	}
+	opts.Labels[metricsLabel] = addr
+}
@@ -0,0 +1,75 @@
+package proxy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const middlewareLabelPrefix = "traefik.http.middlewares."
+
+// MiddlewareCopyOptions selects and renames the middlewares copied from one app to another
+type MiddlewareCopyOptions struct {
+	// Names limits the copy to these middlewares. A name matches a middleware called
+	// exactly that, or one with it as a dash-separated part (ratelimit matches web-ratelimit).
+	Names []string
+	// OldPrefix, if set, is replaced with NewPrefix in the names that start with it.
+	// Other names have the source app name replaced with the destination app name.
+	OldPrefix string
+	NewPrefix string
+}
+
+// CopyMiddlewareLabels returns the middleware labels found in labels, renamed from
+// srcApp to dstApp. routed lists the renamed middlewares that the router of srcApp
+// uses, in order, so they can be added to the router of dstApp.
+func CopyMiddlewareLabels(labels map[string]string, srcApp, dstApp string, opts MiddlewareCopyOptions) (copied map[string]string, routed []string) {
+	renamed := make(map[string]string)
+	copied = make(map[string]string)
+	for key, value := range labels {
+		rest, ok := strings.CutPrefix(key, middlewareLabelPrefix)
+		if !ok {
+			continue
+		}
+		name, option, ok := strings.Cut(rest, ".")
+		if !ok || !matchesMiddleware(name, opts.Names) {
+			continue
+		}
+
+		newName, ok := renamed[name]
+		if !ok {
+			newName = renameMiddleware(name, srcApp, dstApp, opts)
+			renamed[name] = newName
+		}
+		copied[middlewareLabelPrefix+newName+"."+option] = value
+	}
+
+	routerKey := fmt.Sprintf("traefik.http.routers.%s.middlewares", sanitizeName(srcApp))
+	for _, name := range strings.Split(labels[routerKey], ",") {
+		if newName, ok := renamed[strings.TrimSpace(name)]; ok {
+			routed = append(routed, newName)
+		}
+	}
+	return copied, routed
+}
+
+func matchesMiddleware(name string, names []string) bool {
+	if len(names) == 0 {
+		return true
+	}
+	parts := strings.Split(name, "-")
+	for _, want := range names {
+		if name == want || slices.Contains(parts, want) {
+			return true
+		}
+	}
+	return false
+}
+
+func renameMiddleware(name, srcApp, dstApp string, opts MiddlewareCopyOptions) string {
+	if opts.OldPrefix != "" {
+		if rest, ok := strings.CutPrefix(name, opts.OldPrefix); ok {
+			return opts.NewPrefix + rest
+		}
+	}
+	return strings.ReplaceAll(name, sanitizeName(srcApp), sanitizeName(dstApp))
+}
@@ -0,0 +1,56 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// pluginNamePattern keeps plugin names usable in Traefik environment variable names
+var pluginNamePattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+// AddPlugin adds or updates a Traefik plugin and recreates the container to load it
+func AddPlugin(ctx context.Context, dockerClient *docker.Client, plugin PluginConfig) error {
+	if !pluginNamePattern.MatchString(plugin.Name) {
+		return fmt.Errorf("invalid plugin name %q: use letters and digits only", plugin.Name)
+	}
+	if plugin.Module == "" || plugin.Version == "" {
+		return fmt.Errorf("plugin %s needs a module and a version", plugin.Name)
+	}
+
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	settings.Plugins = slices.DeleteFunc(settings.Plugins, func(p PluginConfig) bool { return p.Name == plugin.Name })
+	settings.Plugins = append(settings.Plugins, plugin)
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
+
+// RemovePlugin removes a Traefik plugin and recreates the container without it
+func RemovePlugin(ctx context.Context, dockerClient *docker.Client, name string) error {
+	settings, err := loadBuiltinSettings(ctx, dockerClient)
+	if err != nil {
+		return err
+	}
+
+	remaining := slices.DeleteFunc(slices.Clone(settings.Plugins), func(p PluginConfig) bool { return p.Name == name })
+	if len(remaining) == len(settings.Plugins) {
+		return fmt.Errorf("plugin %s is not configured", name)
+	}
+
+	settings.Plugins = remaining
+	if err := SaveSettings(settings); err != nil {
+		return err
+	}
+
+	return InstallTraefik(ctx, dockerClient)
+}
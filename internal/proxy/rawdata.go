@@ -0,0 +1,88 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formats supported by ExportConfig
+const (
+	ExportFormatJSON = "json"
+	ExportFormatYAML = "yaml"
+)
+
+// ExportConfig fetches the dynamic configuration Traefik is currently routing with
+// (routers, services, middlewares and TLS) from the API at apiURL and renders it
+// as indented JSON or YAML
+func ExportConfig(ctx context.Context, apiURL, format string) ([]byte, error) {
+	if format != ExportFormatJSON && format != ExportFormatYAML {
+		return nil, fmt.Errorf("invalid format %q: must be %s or %s", format, ExportFormatJSON, ExportFormatYAML)
+	}
+
+	raw, err := fetchRawData(ctx, apiURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == ExportFormatYAML {
+		var data any
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, fmt.Errorf("failed to decode Traefik API response: %w", err)
+		}
+		out, err := yaml.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert configuration to YAML: %w", err)
+		}
+		return out, nil
+	}
+
+	// Indent the raw response rather than re-encoding it so that key order is kept
+	var out bytes.Buffer
+	if err := json.Indent(&out, raw, "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to decode Traefik API response: %w", err)
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+// fetchRawData returns the body of the Traefik API rawdata endpoint
+func fetchRawData(ctx context.Context, apiURL string) ([]byte, error) {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	url := strings.TrimRight(apiURL, "/") + "/api/rawdata"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach Traefik API at %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrAPINotEnabled
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("traefik API returned %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Traefik API response: %w", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,221 @@
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Settings is the persisted Traefik configuration, reused whenever the container is (re)created
+type Settings struct {
+	// StaticConfigFile is an absolute path to a traefik.toml or traefik.yaml on the host
+	StaticConfigFile string `json:"static_config_file,omitempty"`
+	// AccessLogFormat enables the access log in this format (common or json) when set
+	AccessLogFormat string `json:"access_log_format,omitempty"`
+	// AccessLogDir is the host directory mounted at /logs for the access log
+	AccessLogDir string `json:"access_log_dir,omitempty"`
+	// DashboardAllowedIPs restricts the dashboard and API to these CIDRs when set
+	DashboardAllowedIPs []string `json:"dashboard_allowed_ips,omitempty"`
+	// Plugins are Traefik plugins loaded at startup
+	Plugins []PluginConfig `json:"plugins,omitempty"`
+	// Entrypoints are custom entrypoints, mapping a name to a [host]:port[/tcp|/udp] address
+	Entrypoints map[string]string `json:"entrypoints,omitempty"`
+	// MetricsAddr enables the Prometheus metrics entrypoint on this address when set
+	MetricsAddr string `json:"metrics_addr,omitempty"`
+	// LocalMode serves plain HTTP only, without the websecure entrypoint on port 443
+	LocalMode bool `json:"local_mode,omitempty"`
+	// Image is the pinned Traefik image; DefaultTraefikVersion is used when empty
+	Image string `json:"image,omitempty"`
+	// NoDashboard turns off the Traefik API and dashboard and leaves port 8080 unpublished
+	NoDashboard bool `json:"no_dashboard,omitempty"`
+}
+
+// DashboardEnabled reports whether the Traefik API and dashboard are served on port 8080
+func (s *Settings) DashboardEnabled() bool {
+	return !s.NoDashboard
+}
+
+// TraefikImage is the image the Traefik container is created from
+func (s *Settings) TraefikImage() string {
+	if s.Image != "" {
+		return s.Image
+	}
+	return traefikRepository + ":" + DefaultTraefikVersion
+}
+
+// PluginConfig is a Traefik plugin from the plugin catalog
+type PluginConfig struct {
+	Name    string `json:"name"`
+	Module  string `json:"module"`
+	Version string `json:"version"`
+}
+
+func settingsPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".finks", "proxy.json"), nil
+}
+
+// LoadSettings reads the proxy settings, returning empty settings if none are saved
+func LoadSettings() (*Settings, error) {
+	path, err := settingsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &Settings{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return settings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proxy settings: %w", err)
+	}
+
+	if err := json.Unmarshal(data, settings); err != nil {
+		return nil, fmt.Errorf("failed to parse proxy settings: %w", err)
+	}
+	return settings, nil
+}
+
+// SaveSettings persists the proxy settings
+func SaveSettings(settings *Settings) error {
+	path, err := settingsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal proxy settings: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write proxy settings: %w", err)
+	}
+	return nil
+}
+
+// SetStaticConfigFile validates a Traefik static configuration file and saves it
+// so it is mounted into the Traefik container. An empty path clears the setting.
+func SetStaticConfigFile(path string) error {
+	settings, err := LoadSettings()
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		settings.StaticConfigFile = ""
+		return SaveSettings(settings)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve config file path: %w", err)
+	}
+
+	if err := validateStaticConfigFile(absPath); err != nil {
+		return err
+	}
+
+	settings.StaticConfigFile = absPath
+	return SaveSettings(settings)
+}
+
+// SetLocalMode saves whether Traefik serves plain HTTP only
+func SetLocalMode(local bool) error {
+	settings, err := LoadSettings()
+	if err != nil {
+		return err
+	}
+	settings.LocalMode = local
+	return SaveSettings(settings)
+}
+
+// SetDashboardEnabled saves whether the Traefik API and dashboard are enabled
+func SetDashboardEnabled(enabled bool) error {
+	settings, err := LoadSettings()
+	if err != nil {
+		return err
+	}
+	settings.NoDashboard = !enabled
+	return SaveSettings(settings)
+}
+
+// SetTraefikVersion pins the Traefik image to a version tag such as v3.1 or latest
+func SetTraefikVersion(tag string) error {
+	if err := ValidateTraefikVersion(tag); err != nil {
+		return err
+	}
+
+	settings, err := LoadSettings()
+	if err != nil {
+		return err
+	}
+	settings.Image = traefikRepository + ":" + tag
+	return SaveSettings(settings)
+}
+
+// ValidateTraefikVersion checks that tag is latest or a version tag starting with v
+func ValidateTraefikVersion(tag string) error {
+	if tag != "latest" && (len(tag) < 2 || !strings.HasPrefix(tag, "v")) {
+		return fmt.Errorf("invalid Traefik version %q: must start with v (e.g. v3.1) or be latest", tag)
+	}
+	if strings.ContainsAny(tag, ":/@ ") {
+		return fmt.Errorf("invalid Traefik version %q: must be a tag, not an image reference", tag)
+	}
+	return nil
+}
+
+func validateStaticConfigFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("config file %s is a directory", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var parsed map[string]any
+	switch staticConfigTarget(path) {
+	case "/traefik.toml":
+		if err := toml.Unmarshal(data, &parsed); err != nil {
+			return fmt.Errorf("invalid TOML in %s: %w", path, err)
+		}
+	case "/traefik.yaml":
+		if err := yaml.Unmarshal(data, &parsed); err != nil {
+			return fmt.Errorf("invalid YAML in %s: %w", path, err)
+		}
+	default:
+		return fmt.Errorf("config file %s must have a .toml, .yaml or .yml extension", path)
+	}
+
+	return nil
+}
+
+// staticConfigTarget returns the path Traefik loads the static config from inside the container
+func staticConfigTarget(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		return "/traefik.toml"
+	case ".yaml", ".yml":
+		return "/traefik.yaml"
+	}
+	return ""
+}
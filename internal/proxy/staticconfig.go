@@ -0,0 +1,132 @@
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats supported by GenerateStaticConfig
+const (
+	StaticConfigFormatTOML = "toml"
+	StaticConfigFormatYAML = "yaml"
+)
+
+// GenerateStaticConfig renders the configuration finks gives Traefik as a static
+// configuration file, for operators who prefer to mount one with --config-file. It
+// follows the same settings as buildRunOptions and adds a Let's Encrypt resolver
+// outside local mode. A comment at the top shows how to run Traefik with the file.
+func GenerateStaticConfig(settings *Settings, email, format string) ([]byte, error) {
+	var target string
+	switch format {
+	case StaticConfigFormatTOML:
+		target = "/traefik.toml"
+	case StaticConfigFormatYAML:
+		target = "/traefik.yaml"
+	default:
+		return nil, fmt.Errorf("invalid format %q: must be %s or %s", format, StaticConfigFormatTOML, StaticConfigFormatYAML)
+	}
+
+	builtin := *settings
+	builtin.StaticConfigFile = ""
+	opts := buildRunOptions(&builtin)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "# Traefik static configuration generated by finks. Run it with:\n#\n")
+	fmt.Fprintf(&out, "#   docker run -d --name %s --network %s \\\n", traefikContainerName, TraefikNetworkName)
+	for _, port := range opts.Ports {
+		fmt.Fprintf(&out, "#     -p %s \\\n", port)
+	}
+	for _, volume := range opts.Volumes {
+		fmt.Fprintf(&out, "#     -v %s \\\n", volume)
+	}
+	fmt.Fprintf(&out, "#     -v $(pwd)/traefik%s:%s:ro \\\n", strings.TrimPrefix(target, "/traefik"), target)
+	fmt.Fprintf(&out, "#     %s\n\n", opts.Image)
+
+	config := staticConfig(settings, email)
+	if format == StaticConfigFormatYAML {
+		encoder := yaml.NewEncoder(&out)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(config); err != nil {
+			return nil, fmt.Errorf("failed to encode YAML: %w", err)
+		}
+		return out.Bytes(), nil
+	}
+
+	if err := toml.NewEncoder(&out).Encode(config); err != nil {
+		return nil, fmt.Errorf("failed to encode TOML: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// staticConfig builds the static configuration tree with Traefik's option names
+func staticConfig(settings *Settings, email string) map[string]any {
+	entryPoints := map[string]any{
+		"web": map[string]any{"address": ":80"},
+	}
+	if settings.DashboardEnabled() {
+		entryPoints["traefik"] = map[string]any{"address": ":8080"}
+	}
+	if !settings.LocalMode {
+		entryPoints["websecure"] = map[string]any{"address": ":443"}
+	}
+	for name, address := range settings.Entrypoints {
+		entryPoints[name] = map[string]any{"address": address}
+	}
+
+	config := map[string]any{
+		"entryPoints": entryPoints,
+		"providers": map[string]any{
+			"docker": map[string]any{"exposedByDefault": false},
+		},
+	}
+	if settings.DashboardEnabled() {
+		config["api"] = map[string]any{
+			"dashboard": true,
+			"insecure":  true,
+		}
+	}
+
+	if !settings.LocalMode {
+		acme := map[string]any{
+			"storage":       acmeStoragePath,
+			"httpChallenge": map[string]any{"entryPoint": "web"},
+		}
+		if email != "" {
+			acme["email"] = email
+		}
+		config["certificatesResolvers"] = map[string]any{
+			"letsencrypt": map[string]any{"acme": acme},
+		}
+	}
+
+	if settings.AccessLogFormat != "" {
+		config["accessLog"] = map[string]any{
+			"filePath": accessLogPath,
+			"format":   settings.AccessLogFormat,
+		}
+	}
+
+	if len(settings.Plugins) > 0 {
+		plugins := make(map[string]any, len(settings.Plugins))
+		for _, plugin := range settings.Plugins {
+			plugins[plugin.Name] = map[string]any{
+				"moduleName": plugin.Module,
+				"version":    plugin.Version,
+			}
+		}
+		config["experimental"] = map[string]any{"plugins": plugins}
+	}
+
+	if settings.MetricsAddr != "" {
+		entryPoints[metricsEntrypoint] = map[string]any{"address": settings.MetricsAddr}
+		config["metrics"] = map[string]any{
+			"prometheus": map[string]any{"entryPoint": metricsEntrypoint},
+		}
+	}
+
+	return config
+}
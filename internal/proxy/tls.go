@@ -0,0 +1,155 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const tlsDialTimeout = 5 * time.Second
+
+var (
+	hostRulePattern = regexp.MustCompile(`Host\(([^)]*)\)`)
+	backtickPattern = regexp.MustCompile("`([^`]+)`")
+)
+
+type routerResponse struct {
+	Name        string   `json:"name"`
+	Rule        string   `json:"rule"`
+	EntryPoints []string `json:"entryPoints"`
+}
+
+// ListRouterDomains returns the unique domains matched by Host rules of the HTTP routers
+// known to the Traefik API at apiURL
+func ListRouterDomains(ctx context.Context, apiURL string) ([]string, error) {
+	routers, err := fetchRouters(ctx, apiURL, "http")
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var domains []string
+	for _, router := range routers {
+		for _, domain := range domainsFromRule(router.Rule) {
+			if !seen[domain] {
+				seen[domain] = true
+				domains = append(domains, domain)
+			}
+		}
+	}
+	sort.Strings(domains)
+
+	return domains, nil
+}
+
+// RoutersUsingEntrypoint returns the HTTP, TCP and UDP routers known to the Traefik
+// API at apiURL that listen on the named entrypoint
+func RoutersUsingEntrypoint(ctx context.Context, apiURL, entrypoint string) ([]string, error) {
+	var names []string
+	for _, protocol := range []string{"http", "tcp", "udp"} {
+		routers, err := fetchRouters(ctx, apiURL, protocol)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range routers {
+			if slices.Contains(router.EntryPoints, entrypoint) {
+				names = append(names, router.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// fetchRouters lists the routers of one protocol (http, tcp or udp) from the Traefik API
+func fetchRouters(ctx context.Context, apiURL, protocol string) ([]routerResponse, error) {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+
+	// The overview call tells an unreachable API apart from one that is not enabled
+	if _, err := CheckTraefikHealth(ctx, apiURL); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	url := strings.TrimRight(apiURL, "/") + "/api/" + protocol + "/routers"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach Traefik API at %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("traefik API returned %s", resp.Status)
+	}
+
+	var routers []routerResponse
+	if err := json.NewDecoder(resp.Body).Decode(&routers); err != nil {
+		return nil, fmt.Errorf("failed to decode Traefik API response: %w", err)
+	}
+	return routers, nil
+}
+
+// domainsFromRule extracts the hosts of every Host(`a`, `b`) matcher in a router rule
+func domainsFromRule(rule string) []string {
+	var domains []string
+	for _, match := range hostRulePattern.FindAllStringSubmatch(rule, -1) {
+		for _, host := range backtickPattern.FindAllStringSubmatch(match[1], -1) {
+			domains = append(domains, strings.ToLower(host[1]))
+		}
+	}
+	return domains
+}
+
+// CheckCertificate dials domain on port 443 and reports the certificate it presents.
+// Verification is skipped so that expired or self-signed certificates are still reported.
+func CheckCertificate(ctx context.Context, domain string) CertificateStatus {
+	status := CertificateStatus{Domain: domain}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: tlsDialTimeout},
+		Config: &tls.Config{
+			ServerName:         domain,
+			InsecureSkipVerify: true,
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(domain, "443"))
+	if err != nil {
+		status.Err = fmt.Errorf("failed to connect to %s: %w", domain, err)
+		return status
+	}
+	defer conn.Close()
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		status.Err = fmt.Errorf("%s presented no certificate", domain)
+		return status
+	}
+
+	leaf := certs[0]
+	status.Issuer = leaf.Issuer.CommonName
+	if status.Issuer == "" && len(leaf.Issuer.Organization) > 0 {
+		status.Issuer = leaf.Issuer.Organization[0]
+	}
+	status.NotAfter = leaf.NotAfter
+	status.DaysLeft = int(time.Until(leaf.NotAfter).Hours() / 24)
+
+	return status
+}
@@ -3,18 +3,62 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/bimalpaudels/finks/internal/docker"
+	"github.com/bimalpaudels/finks/internal/version"
 )
 
+// DefaultNetworkName is the network Traefik reaches routed apps on unless another is configured
+const DefaultNetworkName = "finks-default"
+
+// TraefikNetworkName is the network the Traefik container is created on
+const TraefikNetworkName = "finks-traefik"
+
 const (
-	defaultNetworkName   = "finks-default"
-	traefikNetworkName   = "finks-traefik"
+	finksNetworkPrefix   = "finks-"
 	traefikContainerName = "finks-traefik"
-	traefikImage         = "traefik:v3.0"
+	traefikRepository    = "traefik"
+
+	// DefaultTraefikVersion is the Traefik image tag used unless another version is pinned
+	DefaultTraefikVersion = "v3.0"
+
+	// versionLabel records the finks version that created the Traefik container
+	versionLabel = "finks.version"
+
+	// staticConfigLabel records the host path of the static config file mounted into the container
+	staticConfigLabel = "finks.static-config"
+
+	// accessLogLabel records the access log format the container was created with
+	accessLogLabel = "finks.access-log"
+
+	// dashboardAllowlistLabel records the CIDRs the dashboard was restricted to
+	dashboardAllowlistLabel = "finks.dashboard-allowlist"
+
+	// pluginsLabel records the plugins the container was created with
+	pluginsLabel = "finks.plugins"
+
+	// entrypointsLabel records the custom entrypoints the container was created with
+	entrypointsLabel = "finks.entrypoints"
+
+	// metricsLabel records the address the Prometheus metrics entrypoint listens on
+	metricsLabel = "finks.metrics"
+
+	// httpsLabel records that the websecure entrypoint is published on port 443
+	httpsLabel = "finks.https"
+
+	// imageLabel records the Traefik image the container was created from
+	imageLabel = "finks.image"
+
+	// dashboardLabel is set to false when the container was created without the API and dashboard
+	dashboardLabel = "finks.dashboard"
 )
 
+// settingsLabels are the labels that record which settings a Traefik container was created with
+var settingsLabels = []string{staticConfigLabel, accessLogLabel, dashboardAllowlistLabel, pluginsLabel, entrypointsLabel, metricsLabel, httpsLabel, imageLabel, dashboardLabel}
+
 func GenerateTraefikLabels(config TraefikConfig) map[string]string {
 	labels := make(map[string]string)
 
@@ -23,7 +67,7 @@ func GenerateTraefikLabels(config TraefikConfig) map[string]string {
 	serviceName := sanitizeName(config.AppName)
 	networkName := config.NetworkName
 	if networkName == "" {
-		networkName = defaultNetworkName
+		networkName = DefaultNetworkName
 	}
 
 	// Basic Traefik configuration
@@ -69,6 +113,36 @@ func AddTraefikHealthCheck(labels map[string]string, serviceName, healthPath str
 	}
 }
 
+// RenameTraefikLabels regenerates the Traefik labels of an app for a new name.
+// The domain, port, network and mode are recovered from the existing labels.
+// It returns false when the labels do not describe a Traefik-routed app.
+func RenameTraefikLabels(labels map[string]string, oldName, newName string) (map[string]string, bool) {
+	if labels["traefik.enable"] != "true" {
+		return nil, false
+	}
+
+	oldRouter := sanitizeName(oldName)
+	rule := labels[fmt.Sprintf("traefik.http.routers.%s.rule", oldRouter)]
+	domain := strings.TrimSuffix(strings.TrimPrefix(rule, "Host(`"), "`)")
+	if domain == "" || domain == rule {
+		return nil, false
+	}
+
+	config := TraefikConfig{
+		AppName:     newName,
+		Domain:      domain,
+		Port:        labels[fmt.Sprintf("traefik.http.services.%s.loadbalancer.server.port", oldRouter)],
+		NetworkName: labels["traefik.docker.network"],
+		LocalMode:   labels[fmt.Sprintf("traefik.http.routers.%s.entrypoints", oldRouter)] == "web",
+	}
+
+	renamed := GenerateTraefikLabels(config)
+	healthPath := labels[fmt.Sprintf("traefik.http.services.%s.loadbalancer.healthcheck.path", oldRouter)]
+	AddTraefikHealthCheck(renamed, sanitizeName(newName), healthPath)
+
+	return renamed, true
+}
+
 // sanitizeName cleans app name for use in Traefik router/service names
 func sanitizeName(name string) string {
 	// Replace invalid characters with hyphens
@@ -92,11 +166,33 @@ func InstallTraefik(ctx context.Context, dockerClient *docker.Client) error {
 		return fmt.Errorf("failed to ensure Traefik network: %w", err)
 	}
 
+	settings, err := LoadSettings()
+	if err != nil {
+		return err
+	}
+
 	exists, err := dockerClient.ContainerExists(ctx, traefikContainerName)
 	if err != nil {
 		return fmt.Errorf("failed to check if Traefik container exists: %w", err)
 	}
 
+	if exists {
+		labels, err := dockerClient.GetContainerLabels(ctx, traefikContainerName)
+		if err != nil {
+			return fmt.Errorf("failed to get Traefik container labels: %w", err)
+		}
+
+		// Mounts, environment and labels cannot be changed on an existing container,
+		// so recreate it when the settings it was created with changed
+		desired := buildRunOptions(settings).Labels
+		if slices.ContainsFunc(settingsLabels, func(key string) bool { return labels[key] != desired[key] }) {
+			if err := dockerClient.RemoveContainer(ctx, traefikContainerName, true); err != nil {
+				return fmt.Errorf("failed to remove Traefik container: %w", err)
+			}
+			exists = false
+		}
+	}
+
 	if exists {
 		status, err := dockerClient.GetContainerStatus(ctx, traefikContainerName)
 		if err != nil {
@@ -113,31 +209,105 @@ func InstallTraefik(ctx context.Context, dockerClient *docker.Client) error {
 		return nil
 	}
 
-	if err := dockerClient.PullImage(ctx, traefikImage); err != nil {
+	if err := dockerClient.PullImage(ctx, settings.TraefikImage()); err != nil {
 		return fmt.Errorf("failed to pull Traefik image: %w", err)
 	}
 
-	config := buildTraefikConfig()
-	runOptions := docker.RunOptions{
+	if err := dockerClient.RunContainer(ctx, buildRunOptions(settings)); err != nil {
+		return fmt.Errorf("failed to run Traefik container: %w", err)
+	}
+
+	return nil
+}
+
+// buildRunOptions builds the Traefik container options. A static config file replaces
+// the environment-based configuration, since Traefik only reads one static config source.
+// Port 443 is published unless the proxy is in local mode, where apps are served over HTTP only,
+// and port 8080 unless the dashboard is disabled.
+func buildRunOptions(settings *Settings) docker.RunOptions {
+	ports := []string{"80:80"}
+	if settings.DashboardEnabled() {
+		ports = append(ports, "8080:8080")
+	}
+	if !settings.LocalMode {
+		ports = append(ports, "443:443")
+	}
+
+	opts := docker.RunOptions{
 		Name:     traefikContainerName,
-		Image:    traefikImage,
-		Ports:    []string{"80:80", "8080:8080"},
-		EnvVars:  config,
-		Networks: []string{traefikNetworkName},
+		Image:    settings.TraefikImage(),
+		Ports:    ports,
+		EnvVars:  buildTraefikConfig(),
+		Networks: []string{TraefikNetworkName},
 		Volumes:  buildTraefikVolumes(),
+		Labels:   map[string]string{versionLabel: version.Version, imageLabel: settings.TraefikImage()},
+	}
+	if !settings.LocalMode {
+		opts.Labels[httpsLabel] = "true"
+	}
+	if !settings.DashboardEnabled() {
+		opts.Labels[dashboardLabel] = "false"
 	}
 
-	if err := dockerClient.RunContainer(ctx, runOptions); err != nil {
-		return fmt.Errorf("failed to run Traefik container: %w", err)
+	if settings.StaticConfigFile != "" {
+		opts.EnvVars = nil
+		opts.Volumes = append(opts.Volumes,
+			fmt.Sprintf("%s:%s:ro", settings.StaticConfigFile, staticConfigTarget(settings.StaticConfigFile)))
+		opts.Labels[staticConfigLabel] = settings.StaticConfigFile
+		return opts
 	}
 
-	return nil
+	if !settings.LocalMode {
+		// Routers of apps deployed with a domain outside local mode listen on websecure
+		opts.EnvVars["TRAEFIK_ENTRYPOINTS_WEBSECURE_ADDRESS"] = ":443"
+	}
+
+	if settings.AccessLogFormat != "" {
+		opts.EnvVars["TRAEFIK_ACCESSLOG"] = "true"
+		opts.EnvVars["TRAEFIK_ACCESSLOG_FILEPATH"] = accessLogPath
+		opts.EnvVars["TRAEFIK_ACCESSLOG_FORMAT"] = settings.AccessLogFormat
+		opts.Volumes = append(opts.Volumes, fmt.Sprintf("%s:%s", settings.AccessLogDir, filepath.Dir(accessLogPath)))
+		opts.Labels[accessLogLabel] = settings.AccessLogFormat
+	}
+
+	if !settings.DashboardEnabled() {
+		opts.EnvVars["TRAEFIK_API"] = "false"
+		delete(opts.EnvVars, "TRAEFIK_API_DASHBOARD")
+		delete(opts.EnvVars, "TRAEFIK_API_INSECURE")
+		delete(opts.EnvVars, "TRAEFIK_ENTRYPOINTS_TRAEFIK_ADDRESS")
+	} else if len(settings.DashboardAllowedIPs) > 0 {
+		// The insecure API router cannot take middlewares, so the dashboard is served
+		// through an explicit router on the same entrypoint instead
+		opts.EnvVars["TRAEFIK_API_INSECURE"] = "false"
+		addDashboardAllowlistLabels(opts.Labels, settings.DashboardAllowedIPs)
+	}
+
+	if len(settings.Plugins) > 0 {
+		var recorded []string
+		for _, plugin := range settings.Plugins {
+			prefix := fmt.Sprintf("TRAEFIK_EXPERIMENTAL_PLUGINS_%s_", strings.ToUpper(plugin.Name))
+			opts.EnvVars[prefix+"MODULENAME"] = plugin.Module
+			opts.EnvVars[prefix+"VERSION"] = plugin.Version
+			recorded = append(recorded, fmt.Sprintf("%s=%s@%s", plugin.Name, plugin.Module, plugin.Version))
+		}
+		opts.Labels[pluginsLabel] = strings.Join(recorded, ",")
+	}
+
+	if len(settings.Entrypoints) > 0 {
+		addEntrypoints(&opts, settings.Entrypoints)
+	}
+
+	if settings.MetricsAddr != "" {
+		addMetrics(&opts, settings.MetricsAddr)
+	}
+
+	return opts
 }
 
 func ensureTraefikNetwork(ctx context.Context, dockerClient *docker.Client) error {
-	_, err := dockerClient.EnsureNetwork(ctx, traefikNetworkName, "bridge", nil)
+	_, err := dockerClient.EnsureNetwork(ctx, TraefikNetworkName, "bridge", nil)
 	if err != nil {
-		return fmt.Errorf("failed to ensure network %s: %w", traefikNetworkName, err)
+		return fmt.Errorf("failed to ensure network %s: %w", TraefikNetworkName, err)
 	}
 	return nil
 }
@@ -154,7 +324,6 @@ func buildTraefikConfig() map[string]string {
 	}
 }
 
-
 func buildTraefikVolumes() []string {
 	return []string{
 		"/var/run/docker.sock:/var/run/docker.sock:ro",
@@ -162,7 +331,6 @@ func buildTraefikVolumes() []string {
 	}
 }
 
-
 // GetTraefikStatus checks the status of the Traefik proxy container and network
 func GetTraefikStatus(ctx context.Context, dockerClient *docker.Client) (*TraefikStatus, error) {
 	status := &TraefikStatus{}
@@ -181,15 +349,40 @@ func GetTraefikStatus(ctx context.Context, dockerClient *docker.Client) (*Traefi
 			return nil, fmt.Errorf("failed to get Traefik container status: %w", err)
 		}
 		status.ContainerStatus = containerStatus
-		status.IsRunning = strings.Contains(strings.ToLower(containerStatus), "running")
 
-		if status.IsRunning {
-			status.DashboardURL = "http://localhost:8080/dashboard/"
+		// The list status reads like "Up 3 minutes", so check the state instead
+		detail, err := dockerClient.ContainerInspect(ctx, traefikContainerName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect Traefik container: %w", err)
+		}
+		status.IsRunning = detail.Status == "running"
+
+		// Containers installed before the version label was added leave the version unknown
+		status.InstalledVersion = detail.Labels[versionLabel]
+		status.VersionDrift = status.InstalledVersion != "" && status.InstalledVersion != version.Version
+		status.DashboardEnabled = detail.Labels[dashboardLabel] != "false"
+
+		// Without the dashboard the API is off too, so there is nothing to query
+		if status.IsRunning && status.DashboardEnabled {
+			status.DashboardURL = DashboardURL
+
+			health, err := CheckTraefikHealth(ctx, DefaultAPIURL)
+			if err != nil {
+				status.APIError = err
+			} else {
+				status.RouterCount = health.Routers
+				status.ServiceCount = health.Services
+				status.MiddlewareCount = health.Middlewares
+				// An unreadable acme.json only leaves the certificate count at zero
+				if count, err := countACMECertificates(ctx, dockerClient); err == nil {
+					status.CertCount = count
+				}
+			}
 		}
 	}
 
 	// Check if network exists
-	networkExists, err := dockerClient.NetworkExists(ctx, traefikNetworkName)
+	networkExists, err := dockerClient.NetworkExists(ctx, TraefikNetworkName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check Traefik network: %w", err)
 	}
@@ -197,3 +390,39 @@ func GetTraefikStatus(ctx context.Context, dockerClient *docker.Client) (*Traefi
 
 	return status, nil
 }
+
+// ConnectTraefikToAllAppNetworks attaches the Traefik container to every finks network
+// it is not already on and returns the names of the networks it was connected to
+func ConnectTraefikToAllAppNetworks(ctx context.Context, dockerClient *docker.Client) ([]string, error) {
+	networks, err := dockerClient.ListNetworks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	attached, err := dockerClient.GetContainerNetworks(ctx, traefikContainerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Traefik networks: %w", err)
+	}
+
+	alreadyConnected := make(map[string]bool, len(attached))
+	for _, name := range attached {
+		alreadyConnected[name] = true
+	}
+
+	var connected []string
+	for _, net := range networks {
+		if !strings.HasPrefix(net.Name, finksNetworkPrefix) || net.Name == TraefikNetworkName {
+			continue
+		}
+		if alreadyConnected[net.Name] {
+			continue
+		}
+
+		if err := dockerClient.ConnectContainerToNetwork(ctx, net.Name, traefikContainerName); err != nil {
+			return connected, fmt.Errorf("failed to connect Traefik to network %s: %w", net.Name, err)
+		}
+		connected = append(connected, net.Name)
+	}
+
+	return connected, nil
+}
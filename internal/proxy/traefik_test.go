@@ -0,0 +1,57 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildRunOptionsPortBindings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings Settings
+		want     []string
+	}{
+		{
+			name:     "local mode",
+			settings: Settings{LocalMode: true},
+			want:     []string{"80", "8080"},
+		},
+		{
+			name:     "https",
+			settings: Settings{},
+			want:     []string{"80", "8080", "443"},
+		},
+		{
+			name:     "no dashboard",
+			settings: Settings{NoDashboard: true},
+			want:     []string{"80", "443"},
+		},
+		{
+			name:     "local mode without dashboard",
+			settings: Settings{LocalMode: true, NoDashboard: true},
+			want:     []string{"80"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := buildRunOptions(&tt.settings)
+
+			// RunContainer turns the port specs into bindings the same way
+			exposed, bindings, err := nat.ParsePortSpecs(opts.Ports)
+			require.NoError(t, err)
+			require.Len(t, bindings, len(tt.want))
+			assert.Len(t, exposed, len(tt.want))
+
+			for _, port := range tt.want {
+				containerPort := nat.Port(port + "/tcp")
+				require.Contains(t, bindings, containerPort)
+				require.Len(t, bindings[containerPort], 1)
+				assert.Equal(t, port, bindings[containerPort][0].HostPort)
+			}
+		})
+	}
+}
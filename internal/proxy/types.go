@@ -1,5 +1,7 @@
 package proxy
 
+import "time"
+
 type TraefikConfig struct {
 	AppName     string
 	Domain      string
@@ -14,4 +16,39 @@ type TraefikStatus struct {
 	NetworkExists   bool
 	DashboardURL    string
 	IsRunning       bool
+	// DashboardEnabled is false when the container was installed with --no-dashboard
+	DashboardEnabled bool
+	// InstalledVersion is the finks version that created the Traefik container,
+	// empty when the container has no version label
+	InstalledVersion string
+	// VersionDrift is true when a known InstalledVersion differs from the running binary
+	VersionDrift bool
+
+	// The counts below come from the Traefik API and are only set when APIError is nil
+	RouterCount     int
+	ServiceCount    int
+	MiddlewareCount int
+	// CertCount is the number of certificates in the ACME storage
+	CertCount int
+	// APIError is set when Traefik is running but its API could not be queried
+	APIError error
+}
+
+type TraefikHealth struct {
+	Providers   []string
+	Routers     int
+	Services    int
+	Middlewares int
+	Warnings    int
+	Errors      int
+}
+
+// CertificateStatus describes the certificate a domain presents on port 443
+type CertificateStatus struct {
+	Domain   string
+	Issuer   string
+	NotAfter time.Time
+	DaysLeft int
+	// Err is set when the domain could not be reached or did not complete a TLS handshake
+	Err error
 }
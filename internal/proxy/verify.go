@@ -0,0 +1,180 @@
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+const (
+	// verifyImage serves the verification token during VerifyRouting
+	verifyImage = "busybox:stable"
+
+	// verifyPathPrefix is the path the verification container answers on
+	verifyPathPrefix = "/.well-known/finks-verify/"
+
+	// verifyTimeout is how long VerifyRouting waits for Traefik to route to the verification container
+	verifyTimeout = 20 * time.Second
+)
+
+// publicIPServices return the caller's public IPv4 and IPv6 address as plain text
+var publicIPServices = []string{"https://api.ipify.org", "https://api6.ipify.org"}
+
+// LookupDomain returns the IPv4 and IPv6 addresses a domain resolves to
+func LookupDomain(ctx context.Context, domain string) ([]string, error) {
+	addrs, err := net.DefaultResolver.LookupHost(ctx, domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", domain, err)
+	}
+	return addrs, nil
+}
+
+// PublicIPs returns the public addresses of this server as seen from the internet.
+// A server without IPv6 connectivity only has an IPv4 address.
+func PublicIPs(ctx context.Context) ([]string, error) {
+	var ips []string
+	var lastErr error
+	for _, url := range publicIPServices {
+		ip, err := fetchPublicIP(ctx, url)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		ips = append(ips, ip)
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("failed to determine public IP: %w", lastErr)
+	}
+	return ips, nil
+}
+
+func fetchPublicIP(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response from %s: %w", url, err)
+	}
+
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil {
+		return "", fmt.Errorf("%s returned an invalid address", url)
+	}
+	return ip.String(), nil
+}
+
+// VerifyRouting checks that HTTP requests for domain reach this server's Traefik. A
+// short-lived container serving a random token is routed for the domain under
+// /.well-known/finks-verify/, and the token is fetched through the domain.
+func VerifyRouting(ctx context.Context, dockerClient *docker.Client, domain string) error {
+	if err := requireTraefikContainer(ctx, dockerClient); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	if err := dockerClient.PullImage(ctx, verifyImage); err != nil {
+		return fmt.Errorf("failed to pull %s: %w", verifyImage, err)
+	}
+	if _, err := dockerClient.EnsureNetwork(ctx, DefaultNetworkName, "bridge", nil); err != nil {
+		return fmt.Errorf("failed to ensure network %s: %w", DefaultNetworkName, err)
+	}
+
+	name := "finks-verify-" + token[:8]
+	router := sanitizeName(name)
+	script := fmt.Sprintf("mkdir -p /www%[1]s && echo %[2]s > /www%[1]s%[2]s && exec httpd -f -p 8080 -h /www", verifyPathPrefix, token)
+	err := dockerClient.RunContainer(ctx, docker.RunOptions{
+		Name:          name,
+		Image:         verifyImage,
+		Command:       []string{"sh", "-c", script},
+		Networks:      []string{DefaultNetworkName},
+		RestartPolicy: "no",
+		Labels: map[string]string{
+			"traefik.enable":         "true",
+			"traefik.docker.network": DefaultNetworkName,
+			fmt.Sprintf("traefik.http.routers.%s.rule", router):        fmt.Sprintf("Host(`%s`) && PathPrefix(`%s`)", domain, verifyPathPrefix),
+			fmt.Sprintf("traefik.http.routers.%s.entrypoints", router): "web",
+			// Outrank the app's own routers, including its HTTPS redirect
+			fmt.Sprintf("traefik.http.routers.%s.priority", router):                  "10000",
+			fmt.Sprintf("traefik.http.services.%s.loadbalancer.server.port", router): "8080",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start verification container: %w", err)
+	}
+	defer dockerClient.RemoveContainer(context.WithoutCancel(ctx), name, true)
+
+	// Traefik picks up the new container asynchronously, so retry until it routes
+	url := "http://" + domain + verifyPathPrefix + token
+	deadline := time.Now().Add(verifyTimeout)
+	for {
+		body, err := fetchToken(ctx, url)
+		if err == nil && body == token {
+			return nil
+		}
+		if err == nil {
+			err = fmt.Errorf("%s did not return the verification token", url)
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func fetchToken(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	// A redirect means another router answered, so report it instead of following it
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response from %s: %w", url, err)
+	}
+	return strings.TrimSpace(string(body)), nil
+}
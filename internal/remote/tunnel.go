@@ -0,0 +1,234 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// DefaultDockerSocket is the Docker socket path on the remote host
+const DefaultDockerSocket = "/var/run/docker.sock"
+
+// Options configures an SSH tunnel to a remote Docker daemon
+type Options struct {
+	// Target is the remote host as user@host or user@host:port
+	Target string
+	// KeyPath is the identity file; ssh-agent and the default keys are tried when empty
+	KeyPath string
+	// RemoteSocket is the Docker socket on the remote host
+	RemoteSocket string
+}
+
+// Tunnel forwards a local Unix socket to the Docker socket of a remote host over SSH
+type Tunnel struct {
+	client       *ssh.Client
+	listener     net.Listener
+	dir          string
+	remoteSocket string
+	wg           sync.WaitGroup
+}
+
+// Dial connects to the remote host and listens on a temporary local socket.
+// Call Serve to start forwarding and Close to tear the tunnel down.
+func Dial(opts Options) (*Tunnel, error) {
+	user, addr, err := parseTarget(opts.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := authMethods(opts.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	hostKeyCallback, err := knownHostsCallback()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            user,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+
+	dir, err := os.MkdirTemp("", "finks-ssh-")
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create socket directory: %w", err)
+	}
+
+	listener, err := net.Listen("unix", filepath.Join(dir, "docker.sock"))
+	if err != nil {
+		client.Close()
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to listen on local socket: %w", err)
+	}
+
+	remoteSocket := opts.RemoteSocket
+	if remoteSocket == "" {
+		remoteSocket = DefaultDockerSocket
+	}
+
+	return &Tunnel{
+		client:       client,
+		listener:     listener,
+		dir:          dir,
+		remoteSocket: remoteSocket,
+	}, nil
+}
+
+// DockerHost is the DOCKER_HOST value that points Docker clients at the tunnel
+func (t *Tunnel) DockerHost() string {
+	return "unix://" + t.listener.Addr().String()
+}
+
+// Serve forwards local connections to the remote Docker socket until ctx is
+// cancelled or the tunnel is closed
+func (t *Tunnel) Serve(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		t.listener.Close()
+	}()
+
+	for {
+		local, err := t.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				slog.Error("failed to accept tunnel connection", "error", err)
+			}
+			return
+		}
+
+		t.wg.Add(1)
+		go func() {
+			defer t.wg.Done()
+			t.forward(local)
+		}()
+	}
+}
+
+func (t *Tunnel) forward(local net.Conn) {
+	defer local.Close()
+
+	remote, err := t.client.Dial("unix", t.remoteSocket)
+	if err != nil {
+		slog.Error("failed to reach remote Docker socket", "socket", t.remoteSocket, "error", err)
+		return
+	}
+	defer remote.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(remote, local)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(local, remote)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// Close stops forwarding, disconnects and removes the local socket
+func (t *Tunnel) Close() error {
+	t.listener.Close()
+	err := t.client.Close()
+	t.wg.Wait()
+	os.RemoveAll(t.dir)
+	return err
+}
+
+// parseTarget splits user@host[:port] into the SSH user and a dialable address
+func parseTarget(target string) (string, string, error) {
+	user, host, ok := strings.Cut(target, "@")
+	if !ok || user == "" || host == "" {
+		return "", "", fmt.Errorf("invalid target %q: expected user@host", target)
+	}
+
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+	return user, host, nil
+}
+
+// authMethods uses the given identity file, or else ssh-agent and the default keys in ~/.ssh
+func authMethods(keyPath string) ([]ssh.AuthMethod, error) {
+	if keyPath != "" {
+		signer, err := loadKey(keyPath)
+		if err != nil {
+			return nil, err
+		}
+		return []ssh.AuthMethod{ssh.PublicKeys(signer)}, nil
+	}
+
+	var methods []ssh.AuthMethod
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		if conn, err := net.Dial("unix", sock); err == nil {
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err == nil {
+		var signers []ssh.Signer
+		for _, name := range []string{"id_ed25519", "id_ecdsa", "id_rsa"} {
+			if signer, err := loadKey(filepath.Join(homeDir, ".ssh", name)); err == nil {
+				signers = append(signers, signer)
+			}
+		}
+		if len(signers) > 0 {
+			methods = append(methods, ssh.PublicKeys(signers...))
+		}
+	}
+
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no SSH key found; pass --key or start ssh-agent")
+	}
+	return methods, nil
+}
+
+func loadKey(path string) (ssh.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key %s: %w", path, err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(data)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		return nil, fmt.Errorf("key %s is protected by a passphrase; add it to ssh-agent instead", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s: %w", path, err)
+	}
+	return signer, nil
+}
+
+// knownHostsCallback verifies host keys against ~/.ssh/known_hosts
+func knownHostsCallback() (ssh.HostKeyCallback, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	callback, err := knownhosts.New(filepath.Join(homeDir, ".ssh", "known_hosts"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known_hosts (connect once with ssh to add the host): %w", err)
+	}
+	return callback, nil
+}
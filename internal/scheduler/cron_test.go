@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleMatchesDayFields(t *testing.T) {
+	// 2026-10-05 is a Monday, 2026-10-06 a Tuesday and 2026-10-12 an even Monday
+	oddMonday := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	evenTuesday := time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC)
+	evenMonday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	oddWednesday := time.Date(2026, 10, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want map[time.Time]bool
+	}{
+		// A stepped wildcard still counts as unrestricted, so both fields must match
+		{"0 0 */2 * 1", map[time.Time]bool{oddMonday: true, evenMonday: false, oddWednesday: false, evenTuesday: false}},
+		{"0 0 * * 1", map[time.Time]bool{oddMonday: true, evenMonday: true, oddWednesday: false}},
+		{"0 0 5 * */2", map[time.Time]bool{oddMonday: false, evenTuesday: false}},
+		// With both fields restricted, either one matching is enough
+		{"0 0 7 * 1", map[time.Time]bool{oddMonday: true, evenMonday: true, oddWednesday: true, evenTuesday: false}},
+	}
+
+	for _, tt := range tests {
+		schedule, err := ParseCron(tt.spec)
+		require.NoError(t, err, tt.spec)
+		for day, want := range tt.want {
+			assert.Equal(t, want, schedule.Matches(day), "%s on %s", tt.spec, day.Format("Mon Jan 2"))
+		}
+	}
+}
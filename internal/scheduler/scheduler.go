@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bimalpaudels/finks/internal/docker"
+)
+
+// maxLoggedOutput caps the command output stored per execution in the schedule log
+const maxLoggedOutput = 4096
+
+// Scheduler runs scheduled tasks inside application containers
+type Scheduler struct {
+	dockerClient *docker.Client
+}
+
+func NewScheduler(dockerClient *docker.Client) *Scheduler {
+	return &Scheduler{dockerClient: dockerClient}
+}
+
+// Run checks the schedule at the start of every minute until ctx is cancelled.
+// Tasks are reloaded on each check so newly added schedules are picked up.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		next := time.Now().Truncate(time.Minute).Add(time.Minute)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Until(next)):
+		}
+
+		tasks, err := LoadTasks()
+		if err != nil {
+			slog.Error("failed to load schedules", "error", err)
+			continue
+		}
+
+		for _, task := range tasks {
+			schedule, err := ParseCron(task.Cron)
+			if err != nil {
+				slog.Error("invalid schedule", "task", task.ID, "error", err)
+				continue
+			}
+			if schedule.Matches(next) {
+				go s.execute(ctx, task, next)
+			}
+		}
+	}
+}
+
+// execute runs a task with `docker exec` and records the result
+func (s *Scheduler) execute(ctx context.Context, task Task, startedAt time.Time) {
+	slog.Info("running scheduled task", "task", task.ID, "app", task.App, "command", task.Command)
+
+	var output bytes.Buffer
+	containerName := fmt.Sprintf("finks-%s", task.App)
+	start := time.Now()
+	exitCode, err := s.dockerClient.ExecInContainer(ctx, containerName, []string{"/bin/sh", "-c", task.Command}, &output, &output)
+
+	result := Result{
+		TaskID:    task.ID,
+		App:       task.App,
+		Command:   task.Command,
+		StartedAt: startedAt,
+		Duration:  time.Since(start).Round(time.Millisecond).String(),
+		ExitCode:  exitCode,
+		Output:    lastBytes(output.String(), maxLoggedOutput),
+	}
+	if err != nil {
+		result.Error = err.Error()
+		slog.Error("scheduled task failed", "task", task.ID, "app", task.App, "error", err)
+	} else if exitCode != 0 {
+		slog.Error("scheduled task failed", "task", task.ID, "app", task.App, "exit_code", exitCode)
+	}
+
+	if err := appendResult(result); err != nil {
+		slog.Error("failed to record task result", "task", task.ID, "error", err)
+	}
+}
+
+// lastBytes keeps the end of the output, where errors usually are
+func lastBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[len(s)-n:]
+}
@@ -0,0 +1,193 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Task is a command run inside an application's container on a cron schedule
+type Task struct {
+	ID        string    `json:"id"`
+	App       string    `json:"app"`
+	Cron      string    `json:"cron"`
+	Command   string    `json:"command"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Result is a single task execution, appended to the schedule log
+type Result struct {
+	TaskID    string    `json:"task_id"`
+	App       string    `json:"app"`
+	Command   string    `json:"command"`
+	StartedAt time.Time `json:"started_at"`
+	Duration  string    `json:"duration"`
+	ExitCode  int       `json:"exit_code"`
+	Output    string    `json:"output,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func dataPath(name string) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".finks", name), nil
+}
+
+// LoadTasks reads the scheduled tasks, returning none if nothing is scheduled
+func LoadTasks() ([]Task, error) {
+	path, err := dataPath("schedules.json")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schedules: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(data, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to parse schedules: %w", err)
+	}
+	return tasks, nil
+}
+
+// SaveTasks persists the scheduled tasks
+func SaveTasks(tasks []Task) error {
+	path, err := dataPath("schedules.json")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(tasks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schedules: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write schedules: %w", err)
+	}
+	return nil
+}
+
+// AddTask validates the cron expression and appends a new task
+func AddTask(app, cron, command string) (*Task, error) {
+	if _, err := ParseCron(cron); err != nil {
+		return nil, err
+	}
+
+	tasks, err := LoadTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	task := Task{
+		ID:        fmt.Sprintf("%s-%d", app, time.Now().UnixNano()),
+		App:       app,
+		Cron:      cron,
+		Command:   command,
+		CreatedAt: time.Now(),
+	}
+	tasks = append(tasks, task)
+
+	if err := SaveTasks(tasks); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// RemoveTask deletes the task with the given ID
+func RemoveTask(id string) (*Task, error) {
+	tasks, err := LoadTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(tasks, func(task Task) bool { return task.ID == id })
+	if i < 0 {
+		return nil, fmt.Errorf("task %s not found", id)
+	}
+	removed := tasks[i]
+	tasks = slices.Delete(tasks, i, i+1)
+
+	if err := SaveTasks(tasks); err != nil {
+		return nil, err
+	}
+	return &removed, nil
+}
+
+// RemoveAppTasks deletes the tasks of an application and returns how many there were
+func RemoveAppTasks(app string) (int, error) {
+	tasks, err := LoadTasks()
+	if err != nil {
+		return 0, err
+	}
+
+	kept := slices.DeleteFunc(slices.Clone(tasks), func(task Task) bool { return task.App == app })
+	removed := len(tasks) - len(kept)
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, SaveTasks(kept)
+}
+
+// RenameAppTasks moves the tasks of an application to its new name and returns
+// how many were moved
+func RenameAppTasks(oldName, newName string) (int, error) {
+	tasks, err := LoadTasks()
+	if err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	for i := range tasks {
+		if tasks[i].App == oldName {
+			tasks[i].App = newName
+			moved++
+		}
+	}
+	if moved == 0 {
+		return 0, nil
+	}
+	return moved, SaveTasks(tasks)
+}
+
+// appendResult writes a task execution to ~/.finks/schedule-log.jsonl
+func appendResult(result Result) error {
+	path, err := dataPath("schedule-log.jsonl")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task result: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open schedule log: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write schedule log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveAndRenameTasks(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	web, err := AddTask("web", "0 * * * *", "php artisan schedule:run")
+	require.NoError(t, err)
+	_, err = AddTask("web", "0 0 * * *", "php artisan backup")
+	require.NoError(t, err)
+	worker, err := AddTask("worker", "*/5 * * * *", "echo ok")
+	require.NoError(t, err)
+
+	moved, err := RenameAppTasks("web", "site")
+	require.NoError(t, err)
+	assert.Equal(t, 2, moved)
+
+	removed, err := RemoveTask(web.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "site", removed.App)
+	_, err = RemoveTask(web.ID)
+	assert.Error(t, err)
+
+	count, err := RemoveAppTasks("site")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	tasks, err := LoadTasks()
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, worker.ID, tasks[0].ID)
+}
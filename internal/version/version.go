@@ -0,0 +1,9 @@
+// Package version holds build information for the running finks binary.
+package version
+
+// Build information, populated from main at startup.
+var (
+	Version = "dev"
+	Commit  = "none"
+	Date    = "unknown"
+)
@@ -0,0 +1,84 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultDebounce is how long a directory has to stay quiet before a change is reported
+const DefaultDebounce = 500 * time.Millisecond
+
+// Dir watches dir and its subdirectories and calls onChange once the directory has
+// been quiet for debounce after a change. onChange runs on the watching goroutine, so
+// changes made while it runs are reported once it returns. Dir blocks until ctx is cancelled.
+func Dir(ctx context.Context, dir string, debounce time.Duration, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := addTree(watcher, dir); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			// fsnotify does not recurse, so new directories are added as they appear
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := addTree(watcher, event.Name); err != nil {
+						slog.Error("failed to watch directory", "dir", event.Name, "error", err)
+					}
+				}
+			}
+			timer.Reset(debounce)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			slog.Error("file watcher error", "dir", dir, "error", err)
+
+		case <-timer.C:
+			onChange()
+		}
+	}
+}
+
+// addTree watches root and every directory below it, skipping hidden directories such as .git
+func addTree(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+		return nil
+	})
+}